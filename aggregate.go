@@ -0,0 +1,59 @@
+package null
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrOverflow is returned by SumInt when the sum doesn't fit in an int64,
+// and wrapped by the sized integer conversions and types when a value doesn't fit.
+var ErrOverflow = errors.New("null: integer overflow")
+
+// SumInt returns the sum of the valid values, ignoring nulls like SQL's SUM.
+// The result is null if there are no valid values.
+// It returns ErrOverflow if the sum doesn't fit in an int64.
+func SumInt(vals ...Int) (Int, error) {
+	var sum Int
+	for _, v := range vals {
+		if !v.Valid {
+			continue
+		}
+		if (v.Int64 > 0 && sum.Int64 > math.MaxInt64-v.Int64) ||
+			(v.Int64 < 0 && sum.Int64 < math.MinInt64-v.Int64) {
+			return NewInt(0, false), ErrOverflow
+		}
+		sum.SetValid(sum.Int64 + v.Int64)
+	}
+	return sum, nil
+}
+
+// CountValid returns the number of valid values, like SQL's COUNT.
+// Unlike the other aggregates, it returns 0 rather than null if there are no valid values.
+func CountValid(vals ...Int) int {
+	n := 0
+	for _, v := range vals {
+		if v.Valid {
+			n++
+		}
+	}
+	return n
+}
+
+// AvgFloat returns the average of the valid values, ignoring nulls like SQL's AVG.
+// The result is null if there are no valid values.
+func AvgFloat(vals ...Float) Float {
+	var (
+		sum float64
+		n   int
+	)
+	for _, v := range vals {
+		if v.Valid {
+			sum += v.Float64
+			n++
+		}
+	}
+	if n == 0 {
+		return NewFloat(0, false)
+	}
+	return FloatFrom(sum / float64(n))
+}
@@ -0,0 +1,63 @@
+package null
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSumInt(t *testing.T) {
+	sum, err := SumInt()
+	maybePanic(err)
+	assertNullInt(t, sum, "SumInt() empty")
+
+	sum, err = SumInt(NewInt(1, false), NewInt(2, false))
+	maybePanic(err)
+	assertNullInt(t, sum, "SumInt() all null")
+
+	sum, err = SumInt(IntFrom(12000), NewInt(7, false), IntFrom(345))
+	maybePanic(err)
+	assertInt(t, sum, "SumInt() mixed")
+
+	sum, err = SumInt(IntFrom(0), NewInt(7, false))
+	maybePanic(err)
+	if !sum.Valid || sum.Int64 != 0 {
+		t.Errorf("SumInt() of a single zero = %v, want valid 0", sum)
+	}
+}
+
+func TestSumIntOverflow(t *testing.T) {
+	if _, err := SumInt(IntFrom(math.MaxInt64), IntFrom(1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, not %v", err)
+	}
+	if _, err := SumInt(IntFrom(math.MinInt64), IntFrom(-1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, not %v", err)
+	}
+	sum, err := SumInt(IntFrom(math.MaxInt64), IntFrom(math.MinInt64))
+	maybePanic(err)
+	if sum.Int64 != -1 {
+		t.Errorf("bad sum: %d ≠ %d", sum.Int64, -1)
+	}
+}
+
+func TestCountValid(t *testing.T) {
+	if n := CountValid(); n != 0 {
+		t.Errorf("CountValid() empty = %d, want 0", n)
+	}
+	if n := CountValid(NewInt(1, false), NewInt(2, false)); n != 0 {
+		t.Errorf("CountValid() all null = %d, want 0", n)
+	}
+	if n := CountValid(IntFrom(1), NewInt(2, false), IntFrom(0)); n != 2 {
+		t.Errorf("CountValid() mixed = %d, want 2", n)
+	}
+}
+
+func TestAvgFloat(t *testing.T) {
+	assertNullFloat(t, AvgFloat(), "AvgFloat() empty")
+	assertNullFloat(t, AvgFloat(NewFloat(1, false)), "AvgFloat() all null")
+
+	avg := AvgFloat(FloatFrom(1), NewFloat(100, false), FloatFrom(2))
+	if !avg.Valid || avg.Float64 != 1.5 {
+		t.Errorf("AvgFloat() mixed = %v, want valid 1.5", avg)
+	}
+}
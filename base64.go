@@ -0,0 +1,194 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Base64 is nullable binary data kept as standard, padded base64 text.
+// JSON and text hold the base64 string as is, so it passes through without being decoded and encoded again,
+// while SQL stores the decoded bytes. Input that isn't valid base64 is rejected.
+// It will marshal to null if null.
+type Base64 struct {
+	Base64 string
+	Valid  bool
+}
+
+// Base64From creates a new valid Base64 holding b encoded as base64.
+func Base64From(b []byte) Base64 {
+	return Base64{Base64: base64.StdEncoding.EncodeToString(b), Valid: true}
+}
+
+// Bytes returns the decoded data, or nil if this Base64 is null.
+func (b Base64) Bytes() []byte {
+	if !b.Valid {
+		return nil
+	}
+	data, err := base64.StdEncoding.DecodeString(b.Base64)
+	if err != nil {
+		// only possible if the Base64 field was set to something invalid directly
+		return nil
+	}
+	return data
+}
+
+// String returns the base64 text, or a blank string if this Base64 is null.
+func (b Base64) String() string {
+	if !b.Valid {
+		return ""
+	}
+	return b.Base64
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports []byte, string and nil input, holding the raw data, and encodes it.
+func (b *Base64) Scan(value interface{}) error {
+	return reportError(b.scan(value))
+}
+
+func (b *Base64) scan(value interface{}) error {
+	switch x := scanSource(value).(type) {
+	case nil:
+		*b = Base64{}
+	case []byte:
+		*b = Base64From(x)
+	case string:
+		*b = Base64From([]byte(x))
+	default:
+		*b = Base64{}
+		return newScanError("Base64", value, errUnsupportedScanType)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It passes the decoded bytes to the driver.
+func (b Base64) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(b.Base64)
+	if err != nil {
+		return nil, fmt.Errorf("null: Base64: couldn't decode value: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input. The string must be standard, padded base64.
+func (b *Base64) UnmarshalJSON(data []byte) error {
+	return reportError(b.unmarshalJSON(data))
+}
+
+func (b *Base64) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*b = Base64{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		*b = Base64{}
+		return newUnmarshalError("Base64", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	parsed, err := parseBase64(str)
+	if err != nil {
+		*b = Base64{}
+		return newUnmarshalError("Base64", err)
+	}
+	*b = parsed
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Base64 if the input is blank.
+func (b *Base64) UnmarshalText(text []byte) error {
+	return reportError(b.unmarshalText(text))
+}
+
+func (b *Base64) unmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*b = Base64{}
+		return nil
+	}
+	parsed, err := parseBase64(string(text))
+	if err != nil {
+		*b = Base64{}
+		return newUnmarshalError("Base64", err)
+	}
+	*b = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Base64 is null, otherwise the base64 string as is.
+func (b Base64) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.Quote(b.Base64)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Base64 is null.
+func (b Base64) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// Reset makes this Base64 null and clears its value, so a reused Base64 doesn't keep previous data.
+func (b *Base64) Reset() {
+	*b = Base64{}
+}
+
+// IsZero returns true for null Base64s.
+// A non-null Base64 holding no data will not be considered zero.
+func (b Base64) IsZero() bool {
+	return !b.Valid
+}
+
+// AsAny returns nil if this Base64 is null, otherwise the base64 text.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (b Base64) AsAny() interface{} {
+	if !b.Valid {
+		return nil
+	}
+	return b.Base64
+}
+
+// Equal returns true if both Base64s hold the same data or are both null.
+func (b Base64) Equal(other Base64) bool {
+	return b.Valid == other.Valid && (!b.Valid || b.Base64 == other.Base64)
+}
+
+// JSONSchema returns the JSON Schema of this Base64's JSON encoding: a base64 string or null.
+func (b Base64) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("string")
+	schema["contentEncoding"] = "base64"
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Base64 as the Go code that creates it.
+func (b Base64) GoString() string {
+	if !b.Valid {
+		return "null.Base64{}"
+	}
+	return "null.Base64{Base64: " + strconv.Quote(b.Base64) + ", Valid: true}"
+}
+
+// parseBase64 validates standard, padded base64 text.
+// Line breaks, which the decoder would skip, are rejected so the text is kept in a single form.
+func parseBase64(s string) (Base64, error) {
+	if strings.ContainsAny(s, "\r\n") {
+		return Base64{}, fmt.Errorf("invalid base64 %q: contains a line break", s)
+	}
+	if _, err := base64.StdEncoding.Strict().DecodeString(s); err != nil {
+		return Base64{}, fmt.Errorf("invalid base64 %q: %w", s, err)
+	}
+	return Base64{Base64: s, Valid: true}, nil
+}
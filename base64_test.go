@@ -0,0 +1,81 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestBase64JSON(t *testing.T) {
+	var b Base64
+	err := json.Unmarshal([]byte(`"aGVsbG8="`), &b)
+	maybePanic(err)
+	if !b.Valid || !bytes.Equal(b.Bytes(), []byte("hello")) {
+		t.Errorf("bad unmarshal: %#v", b)
+	}
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"aGVsbG8="`, "base64 json marshal")
+
+	err = json.Unmarshal([]byte(`""`), &b)
+	maybePanic(err)
+	if !b.Valid || len(b.Bytes()) != 0 {
+		t.Errorf("empty string should be valid empty data: %#v", b)
+	}
+
+	var null Base64
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null base64 json marshal")
+	if null.Bytes() != nil {
+		t.Error("null Bytes() should be nil")
+	}
+}
+
+func TestBase64Invalid(t *testing.T) {
+	for _, in := range []string{`"aGVsbG8"`, `"aGVsbG9="`, `"not base64!"`, `"aGVs\nbG8="`, `"aGVsbG8_"`, `12`, `true`} {
+		b := Base64From([]byte("x"))
+		if err := json.Unmarshal([]byte(in), &b); err == nil {
+			t.Errorf("expected error for %s", in)
+		}
+		if b.Valid {
+			t.Errorf("%s should be null", in)
+		}
+	}
+	b := Base64From([]byte("x"))
+	if err := b.UnmarshalText([]byte("%%%")); err == nil || b.Valid {
+		t.Error("expected error and null Base64 for invalid text")
+	}
+}
+
+func TestBase64ScanValue(t *testing.T) {
+	raw := []byte{0, 1, 0xfe, 0xff}
+	var b Base64
+	err := b.Scan(raw)
+	maybePanic(err)
+	if !b.Valid || b.Base64 != "AAH+/w==" {
+		t.Errorf("bad scan: %#v", b)
+	}
+	v, err := b.Value()
+	maybePanic(err)
+	if got, ok := v.([]byte); !ok || !bytes.Equal(got, raw) {
+		t.Errorf("bad value: %#v", v)
+	}
+
+	err = b.Scan("hello")
+	maybePanic(err)
+	if !b.Equal(Base64From([]byte("hello"))) {
+		t.Errorf("bad scan of string: %#v", b)
+	}
+
+	err = b.Scan(nil)
+	maybePanic(err)
+	if v, err := b.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+	if err := b.Scan(int64(1)); err == nil || b.Valid {
+		t.Error("expected error scanning an int")
+	}
+}
@@ -1,6 +1,10 @@
 package null
 
 import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -43,3 +47,115 @@ func BenchmarkNullStringUnmarshalJSON(b *testing.B) {
 		_ = nullable.UnmarshalJSON(input)
 	}
 }
+
+func BenchmarkStringMarshalJSON(b *testing.B) {
+	nullable := StringFrom("hello world")
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, _ = nullable.MarshalJSON()
+	}
+}
+
+func BenchmarkStringEscapedMarshalJSON(b *testing.B) {
+	nullable := StringFrom("hello \"world\" <3")
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, _ = nullable.MarshalJSON()
+	}
+}
+
+func largeIntArrayJSON() []byte {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i := 0; i < 10000; i++ {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		if i%10 == 0 {
+			sb.WriteString("null")
+		} else {
+			sb.WriteString(strconv.Itoa(i * 12345))
+		}
+	}
+	sb.WriteByte(']')
+	return []byte(sb.String())
+}
+
+func BenchmarkIntSliceUnmarshalJSON(b *testing.B) {
+	input := largeIntArrayJSON()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		var ints []Int
+		_ = json.Unmarshal(input, &ints)
+	}
+}
+
+func BenchmarkIntSliceDecodeSlice(b *testing.B) {
+	input := largeIntArrayJSON()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, _ = DecodeSlice[Int](json.NewDecoder(bytes.NewReader(input)))
+	}
+}
+
+// BenchmarkIntSliceDecodeRawMessage decodes each element into a reused json.RawMessage first,
+// which DecodeSlice avoids, for comparison.
+func BenchmarkIntSliceDecodeRawMessage(b *testing.B) {
+	input := largeIntArrayJSON()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		dec := json.NewDecoder(bytes.NewReader(input))
+		_, _ = dec.Token()
+		var (
+			out []Int
+			raw json.RawMessage
+		)
+		for i := 0; dec.More(); i++ {
+			_ = dec.Decode(&raw)
+			out = append(out, Int{})
+			_ = out[i].UnmarshalJSON(raw)
+		}
+	}
+}
+
+func BenchmarkUnmarshalInts(b *testing.B) {
+	input := largeIntArrayJSON()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, _ = UnmarshalInts(input)
+	}
+}
+
+func BenchmarkIntMarshalText(b *testing.B) {
+	i := IntFrom(1234567890)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, _ = i.MarshalText()
+	}
+}
+
+func BenchmarkIntAppendText(b *testing.B) {
+	i := IntFrom(1234567890)
+	buf := make([]byte, 0, 32)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf, _ = i.AppendText(buf[:0])
+	}
+}
+
+func BenchmarkTimestampMarshalText(b *testing.B) {
+	ts := TimestampFrom(timestampValue)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, _ = ts.MarshalText()
+	}
+}
+
+func BenchmarkTimestampAppendText(b *testing.B) {
+	ts := TimestampFrom(timestampValue)
+	buf := make([]byte, 0, 32)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf, _ = ts.AppendText(buf[:0])
+	}
+}
@@ -0,0 +1,416 @@
+package null
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"time"
+)
+
+// Type tags of the binary format used by AppendBinary and ReadBinary.
+// Every value is encoded as its tag byte, a validity byte (0 or 1) and,
+// only if valid, its payload:
+//
+//	String        uvarint length, then the bytes of the string
+//	Int           8 bytes, big endian two's complement
+//	Float         8 bytes, big endian IEEE 754 bits
+//	Bool          1 byte, 0 or 1
+//	Time          uvarint length, then time.Time's MarshalBinary output
+//	Timestamp     same as Time
+//	Int16         2 bytes, big endian two's complement
+//	Int32         4 bytes, big endian two's complement
+//	Uint          8 bytes, big endian
+//	Percent       same as Float
+//	FormBool      same as Bool
+//	Month         1 byte, the month number
+//	Color         4 bytes, R, G, B and A
+//	ByteSize      same as Int
+//	CIString      same as String
+//	SecretString  same as String, holding the real value
+//	Decimal       same as String
+//	Email         same as String
+//	Lang          same as String, holding the canonical tag
+//	Phone         same as String
+//	Number        same as String
+//	Base64        same as String, holding the base64 text
+//	Rat           same as String, holding the fraction as a/b
+//	Semver        uvarint major, minor and patch, then the pre-release and build as Strings are
+//
+// Generic types (EnumInt, Tagged), Ints and types holding functions (EncryptedString,
+// ValidatedString, ValidatedInt) aren't supported, as ReadBinary couldn't restore them.
+// A valid Rat with a nil Rat is encoded as null.
+const (
+	binaryString byte = iota + 1
+	binaryInt
+	binaryFloat
+	binaryBool
+	binaryTime
+	binaryTimestamp
+	binaryInt16
+	binaryInt32
+	binaryUint
+	binaryPercent
+	binaryFormBool
+	binaryMonth
+	binaryColor
+	binaryByteSize
+	binaryCIString
+	binarySecretString
+	binaryDecimal
+	binaryEmail
+	binaryLang
+	binaryPhone
+	binaryNumber
+	binaryBase64
+	binaryRat
+	binarySemver
+)
+
+// errBinaryTruncated is returned by ReadBinary when the input ends in the middle of a value.
+var errBinaryTruncated = fmt.Errorf("null: truncated binary input: %w", io.ErrUnexpectedEOF)
+
+// AppendBinary appends the binary encoding of v to dst and returns the extended buffer.
+// v must be one of the types listed with the binary format above.
+func AppendBinary(dst []byte, v Nullable) ([]byte, error) {
+	if out, ok := appendBinaryFixed(dst, v); ok {
+		return out, nil
+	}
+	if out, ok, err := appendBinaryVar(dst, v); ok {
+		return out, err
+	}
+	return dst, fmt.Errorf("null: unsupported type for AppendBinary: %T", v)
+}
+
+// appendBinaryFixed appends v if it is a type with a fixed-size payload.
+func appendBinaryFixed(dst []byte, v Nullable) ([]byte, bool) {
+	switch v := v.(type) {
+	case Int:
+		return appendBinaryNumber(dst, binaryInt, v.Valid, uint64(v.Int64), 8), true
+	case Int16:
+		return appendBinaryNumber(dst, binaryInt16, v.Valid, uint64(uint16(v.Int16)), 2), true
+	case Int32:
+		return appendBinaryNumber(dst, binaryInt32, v.Valid, uint64(uint32(v.Int32)), 4), true
+	case Uint:
+		return appendBinaryNumber(dst, binaryUint, v.Valid, v.Uint64, 8), true
+	case ByteSize:
+		return appendBinaryNumber(dst, binaryByteSize, v.Valid, uint64(v.Bytes), 8), true
+	case Float:
+		return appendBinaryNumber(dst, binaryFloat, v.Valid, math.Float64bits(v.Float64), 8), true
+	case Percent:
+		return appendBinaryNumber(dst, binaryPercent, v.Valid, math.Float64bits(v.Float64), 8), true
+	case Bool:
+		return appendBinaryNumber(dst, binaryBool, v.Valid, binaryBoolValue(v.Bool), 1), true
+	case FormBool:
+		return appendBinaryNumber(dst, binaryFormBool, v.Valid, binaryBoolValue(v.Bool.Bool), 1), true
+	case Month:
+		return appendBinaryNumber(dst, binaryMonth, v.Valid, uint64(uint8(v.Month)), 1), true
+	case Color:
+		rgba := uint64(v.R)<<24 | uint64(v.G)<<16 | uint64(v.B)<<8 | uint64(v.A)
+		return appendBinaryNumber(dst, binaryColor, v.Valid, rgba, 4), true
+	}
+	return dst, false
+}
+
+// appendBinaryVar appends v if it is a type with a variable-size payload.
+func appendBinaryVar(dst []byte, v Nullable) ([]byte, bool, error) {
+	switch v := v.(type) {
+	case String:
+		return appendBinaryText(dst, binaryString, v.Valid, v.String), true, nil
+	case CIString:
+		return appendBinaryText(dst, binaryCIString, v.Valid, v.String.String), true, nil
+	case SecretString:
+		return appendBinaryText(dst, binarySecretString, v.Valid, v.secret), true, nil
+	case Decimal:
+		return appendBinaryText(dst, binaryDecimal, v.Valid, v.Decimal), true, nil
+	case Email:
+		return appendBinaryText(dst, binaryEmail, v.Valid, v.Address), true, nil
+	case Lang:
+		return appendBinaryText(dst, binaryLang, v.Valid, v.String()), true, nil
+	case Phone:
+		return appendBinaryText(dst, binaryPhone, v.Valid, v.Number), true, nil
+	case Number:
+		return appendBinaryText(dst, binaryNumber, v.Valid, string(v.Number)), true, nil
+	case Base64:
+		return appendBinaryText(dst, binaryBase64, v.Valid, v.Base64), true, nil
+	case Rat:
+		return appendBinaryText(dst, binaryRat, v.Valid && v.Rat != nil, v.String()), true, nil
+	case Semver:
+		return appendBinarySemver(dst, v), true, nil
+	case Time:
+		out, err := appendBinaryTime(dst, binaryTime, v.NullTime.Time, v.Valid)
+		return out, true, err
+	case Timestamp:
+		out, err := appendBinaryTime(dst, binaryTimestamp, v.NullTime.Time, v.Valid)
+		return out, true, err
+	}
+	return dst, false, nil
+}
+
+func appendBinaryHeader(dst []byte, tag byte, valid bool) []byte {
+	return appendBinaryBool(append(dst, tag), valid)
+}
+
+func appendUvarint(dst []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(dst, buf[:n]...)
+}
+
+// appendBinaryNumber appends a header and, if valid, the low size bytes of v, big endian.
+func appendBinaryNumber(dst []byte, tag byte, valid bool, v uint64, size int) []byte {
+	dst = appendBinaryHeader(dst, tag, valid)
+	if !valid {
+		return dst
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	return append(dst, buf[8-size:]...)
+}
+
+// appendBinaryText appends a header and, if valid, s with its length.
+func appendBinaryText(dst []byte, tag byte, valid bool, s string) []byte {
+	dst = appendBinaryHeader(dst, tag, valid)
+	if !valid {
+		return dst
+	}
+	return appendBinaryString(dst, s)
+}
+
+func appendBinaryString(dst []byte, s string) []byte {
+	return append(appendUvarint(dst, uint64(len(s))), s...)
+}
+
+func appendBinarySemver(dst []byte, v Semver) []byte {
+	dst = appendBinaryHeader(dst, binarySemver, v.Valid)
+	if !v.Valid {
+		return dst
+	}
+	dst = appendUvarint(appendUvarint(appendUvarint(dst, v.Major), v.Minor), v.Patch)
+	return appendBinaryString(appendBinaryString(dst, v.Prerelease), v.Build)
+}
+
+func binaryBoolValue(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func appendBinaryBool(dst []byte, b bool) []byte {
+	if b {
+		return append(dst, 1)
+	}
+	return append(dst, 0)
+}
+
+func appendBinaryTime(dst []byte, tag byte, t time.Time, valid bool) ([]byte, error) {
+	dst = appendBinaryHeader(dst, tag, valid)
+	if !valid {
+		return dst, nil
+	}
+	data, err := t.MarshalBinary()
+	if err != nil {
+		return dst, fmt.Errorf("null: couldn't marshal time: %w", err)
+	}
+	dst = appendUvarint(dst, uint64(len(data)))
+	return append(dst, data...), nil
+}
+
+// ReadBinary decodes a single value written by AppendBinary from the start of data.
+// It returns the value and the number of bytes read.
+func ReadBinary(data []byte) (Nullable, int, error) {
+	if len(data) < 2 {
+		return nil, 0, errBinaryTruncated
+	}
+	tag, valid, payload := data[0], data[1], data[2:]
+	if valid > 1 {
+		return nil, 0, fmt.Errorf("null: invalid validity byte in binary input: %d", valid)
+	}
+
+	read, ok := binaryReaders[tag]
+	if !ok {
+		return nil, 0, fmt.Errorf("null: unknown type tag in binary input: %d", tag)
+	}
+	v, n, err := read(payload, valid == 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	return v, n + 2, nil
+}
+
+// binaryReader decodes the payload of a value with the given validity.
+// It returns the value and the number of payload bytes read.
+type binaryReader func(payload []byte, valid bool) (Nullable, int, error)
+
+// binaryReaders holds the reader of every type tag.
+var binaryReaders = map[byte]binaryReader{
+	binaryString:       readBinaryText(NewString),
+	binaryCIString:     readBinaryText(NewCIString),
+	binarySecretString: readBinaryText(NewSecretString),
+	binaryDecimal:      readBinaryText(func(s string, valid bool) Decimal { return Decimal{Decimal: s, Valid: valid} }),
+	binaryEmail:        readBinaryText(func(s string, valid bool) Email { return Email{Address: s, Valid: valid} }),
+	binaryLang:         readBinaryLang,
+	binaryPhone:        readBinaryText(func(s string, valid bool) Phone { return Phone{Number: s, Valid: valid} }),
+	binaryNumber:       readBinaryText(func(s string, valid bool) Number { return NewNumber(json.Number(s), valid) }),
+	binaryBase64:       readBinaryText(func(s string, valid bool) Base64 { return Base64{Base64: s, Valid: valid} }),
+	binaryRat:          readBinaryRat,
+	binarySemver:       readBinarySemver,
+	binaryInt:          readBinaryNumber(8, func(u uint64, valid bool) Nullable { return NewInt(int64(u), valid) }),
+	binaryInt16:        readBinaryNumber(2, func(u uint64, valid bool) Nullable { return NewInt16(int16(u), valid) }),
+	binaryInt32:        readBinaryNumber(4, func(u uint64, valid bool) Nullable { return NewInt32(int32(u), valid) }),
+	binaryUint:         readBinaryNumber(8, func(u uint64, valid bool) Nullable { return NewUint(u, valid) }),
+	binaryByteSize:     readBinaryNumber(8, func(u uint64, valid bool) Nullable { return NewByteSize(int64(u), valid) }),
+	binaryFloat:        readBinaryNumber(8, func(u uint64, valid bool) Nullable { return NewFloat(math.Float64frombits(u), valid) }),
+	binaryPercent:      readBinaryNumber(8, func(u uint64, valid bool) Nullable { return NewPercent(math.Float64frombits(u), valid) }),
+	binaryMonth:        readBinaryMonth,
+	binaryColor: readBinaryNumber(4, func(u uint64, valid bool) Nullable {
+		return NewColor(uint8(u>>24), uint8(u>>16), uint8(u>>8), uint8(u), valid)
+	}),
+	binaryBool:     readBinaryBoolValue(func(b, valid bool) Nullable { return NewBool(b, valid) }),
+	binaryFormBool: readBinaryBoolValue(func(b, valid bool) Nullable { return NewFormBool(b, valid) }),
+	binaryTime: func(payload []byte, valid bool) (Nullable, int, error) {
+		t, n, err := readBinaryTime(payload, valid)
+		return NewTime(t, valid), n, err
+	},
+	binaryTimestamp: func(payload []byte, valid bool) (Nullable, int, error) {
+		t, n, err := readBinaryTime(payload, valid)
+		return NewTimestamp(t, valid), n, err
+	},
+}
+
+// readBinaryText returns a reader of string payloads that builds values with mk.
+func readBinaryText[T Nullable](mk func(s string, valid bool) T) binaryReader {
+	return func(payload []byte, valid bool) (Nullable, int, error) {
+		b, n, err := readBinaryBytes(payload, valid)
+		return mk(string(b), valid), n, err
+	}
+}
+
+// readBinaryNumber returns a reader of size-byte big endian payloads that builds values with mk.
+func readBinaryNumber(size int, mk func(u uint64, valid bool) Nullable) binaryReader {
+	return func(payload []byte, valid bool) (Nullable, int, error) {
+		if !valid {
+			return mk(0, false), 0, nil
+		}
+		if len(payload) < size {
+			return nil, 0, errBinaryTruncated
+		}
+		var buf [8]byte
+		copy(buf[8-size:], payload[:size])
+		return mk(binary.BigEndian.Uint64(buf[:]), true), size, nil
+	}
+}
+
+// readBinaryBoolValue returns a reader of bool payloads that builds values with mk.
+func readBinaryBoolValue(mk func(b, valid bool) Nullable) binaryReader {
+	return func(payload []byte, valid bool) (Nullable, int, error) {
+		b, n, err := readBinaryBool(payload, valid)
+		return mk(b, valid), n, err
+	}
+}
+
+func readBinaryRat(payload []byte, valid bool) (Nullable, int, error) {
+	b, n, err := readBinaryBytes(payload, valid)
+	if err != nil || !valid {
+		return Rat{}, n, err
+	}
+	r, ok := new(big.Rat).SetString(string(b))
+	if !ok {
+		return nil, 0, fmt.Errorf("null: invalid Rat in binary input: %q", b)
+	}
+	return RatFrom(r), n, nil
+}
+
+func readBinaryMonth(payload []byte, valid bool) (Nullable, int, error) {
+	if !valid {
+		return Month{}, 0, nil
+	}
+	if len(payload) < 1 {
+		return nil, 0, errBinaryTruncated
+	}
+	m := time.Month(payload[0])
+	if m < time.January || m > time.December {
+		return nil, 0, fmt.Errorf("null: invalid Month in binary input: %d is out of range [1, 12]", payload[0])
+	}
+	return MonthFrom(m), 1, nil
+}
+
+func readBinaryLang(payload []byte, valid bool) (Nullable, int, error) {
+	b, n, err := readBinaryBytes(payload, valid)
+	if err != nil || !valid {
+		return Lang{}, n, err
+	}
+	l, err := parseLang(string(b))
+	if err != nil {
+		return nil, 0, fmt.Errorf("null: invalid Lang in binary input: %w", err)
+	}
+	return l, n, nil
+}
+
+func readBinarySemver(payload []byte, valid bool) (Nullable, int, error) {
+	if !valid {
+		return Semver{}, 0, nil
+	}
+	var (
+		v    = Semver{Valid: true}
+		read int
+	)
+	for _, dst := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		u, n := binary.Uvarint(payload[read:])
+		if n <= 0 {
+			return nil, 0, errBinaryTruncated
+		}
+		*dst = u
+		read += n
+	}
+	for _, dst := range []*string{&v.Prerelease, &v.Build} {
+		b, n, err := readBinaryBytes(payload[read:], true)
+		if err != nil {
+			return nil, 0, err
+		}
+		*dst = string(b)
+		read += n
+	}
+	return v, read, nil
+}
+
+func readBinaryBytes(data []byte, valid bool) ([]byte, int, error) {
+	if !valid {
+		return nil, 0, nil
+	}
+	size, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < size {
+		return nil, 0, errBinaryTruncated
+	}
+	end := n + int(size)
+	return data[n:end], end, nil
+}
+
+func readBinaryBool(data []byte, valid bool) (bool, int, error) {
+	if !valid {
+		return false, 0, nil
+	}
+	if len(data) < 1 {
+		return false, 0, errBinaryTruncated
+	}
+	if data[0] > 1 {
+		return false, 0, errors.New("null: invalid bool in binary input")
+	}
+	return data[0] == 1, 1, nil
+}
+
+func readBinaryTime(data []byte, valid bool) (time.Time, int, error) {
+	var t time.Time
+	b, n, err := readBinaryBytes(data, valid)
+	if err != nil || !valid {
+		return t, n, err
+	}
+	if err := t.UnmarshalBinary(b); err != nil {
+		return t, 0, fmt.Errorf("null: couldn't unmarshal binary time: %w", err)
+	}
+	return t, n, nil
+}
@@ -0,0 +1,143 @@
+package null
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	values := []Nullable{
+		StringFrom("test"),
+		StringFrom(""),
+		NewString("", false),
+		IntFrom(12345),
+		IntFrom(math.MinInt64),
+		NewInt(0, false),
+		FloatFrom(1.2345),
+		FloatFrom(math.Inf(-1)),
+		NewFloat(0, false),
+		BoolFrom(true),
+		BoolFrom(false),
+		NewBool(false, false),
+		TimeFrom(timeValue2),
+		NewTime(time.Time{}, false),
+		TimestampFrom(timestampValue),
+		NewTimestamp(time.Time{}, false),
+		Int16From(math.MinInt16),
+		NewInt16(0, false),
+		Int32From(-12345),
+		NewInt32(0, false),
+		UintFrom(math.MaxUint64),
+		NewUint(0, false),
+		PercentFrom(42.5),
+		NewPercent(0, false),
+		FormBoolFrom(true),
+		NewFormBool(false, false),
+		MonthFrom(time.December),
+		NewMonth(0, false),
+		NewColor(0x11, 0x22, 0x33, 0x44, true),
+		Color{},
+		ByteSizeFrom(1 << 40),
+		NewByteSize(0, false),
+		CIStringFrom("Test"),
+		NewCIString("", false),
+		SecretStringFrom("hunter2"),
+		NewSecretString("", false),
+		Decimal{Decimal: "-1.50", Valid: true},
+		Decimal{},
+		Email{Address: "user@example.com", Valid: true},
+		Email{},
+		MustLang("en-US"),
+		Lang{},
+		Phone{Number: "+14155552671", Valid: true},
+		Phone{},
+		NumberFrom("1.5e300"),
+		NewNumber("", false),
+		Base64From([]byte("hello")),
+		Base64{},
+		MustRat("-3/4"),
+		Rat{},
+		Semver{Major: 1, Minor: 200, Patch: 3, Prerelease: "rc.1", Build: "sha.abc", Valid: true},
+		Semver{},
+	}
+
+	var data []byte
+	for _, v := range values {
+		var err error
+		data, err = AppendBinary(data, v)
+		maybePanic(err)
+	}
+
+	for i, want := range values {
+		got, n, err := ReadBinary(data)
+		maybePanic(err)
+		if !binaryEqual(got, want) {
+			t.Errorf("value %d: ReadBinary() = %#v, want %#v", i, got, want)
+		}
+		data = data[n:]
+	}
+	if len(data) != 0 {
+		t.Errorf("%d bytes left after reading all values", len(data))
+	}
+}
+
+func TestBinaryTruncated(t *testing.T) {
+	values := []Nullable{
+		StringFrom("test"), IntFrom(1), FloatFrom(1), BoolFrom(true), TimeFrom(timeValue1),
+		Int16From(1), Int32From(1), MonthFrom(time.May), NewColor(1, 2, 3, 4, true), MustRat("1/3"),
+		Semver{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "b", Valid: true},
+	}
+	for _, v := range values {
+		data, err := AppendBinary(nil, v)
+		maybePanic(err)
+		for i := 0; i < len(data); i++ {
+			if _, _, err := ReadBinary(data[:i]); !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("%T truncated to %d bytes: expected io.ErrUnexpectedEOF, not %v", v, i, err)
+			}
+		}
+	}
+}
+
+func TestBinaryInvalidInput(t *testing.T) {
+	if _, _, err := ReadBinary([]byte{0xff, 0}); err == nil {
+		t.Error("expected error for unknown tag")
+	}
+	if _, _, err := ReadBinary([]byte{binaryInt, 2}); err == nil {
+		t.Error("expected error for bad validity byte")
+	}
+	if _, _, err := ReadBinary([]byte{binaryBool, 1, 2}); err == nil {
+		t.Error("expected error for bad bool")
+	}
+	if _, err := AppendBinary(nil, binaryUnsupported{}); err == nil {
+		t.Error("expected error for unsupported type")
+	}
+	if _, err := AppendBinary(nil, TaggedFrom(1)); err == nil {
+		t.Error("expected error for Tagged")
+	}
+	if _, _, err := ReadBinary([]byte{binaryRat, 1, 3, 'a', '/', 'b'}); err == nil {
+		t.Error("expected error for bad Rat")
+	}
+	for _, month := range []byte{0, 13, 0xff} {
+		if _, _, err := ReadBinary([]byte{binaryMonth, 1, month}); err == nil {
+			t.Errorf("expected error for Month %d", month)
+		}
+	}
+}
+
+type binaryUnsupported struct {
+	Int
+}
+
+func binaryEqual(a, b Nullable) bool {
+	switch a := a.(type) {
+	case Time:
+		return a.ExactEqual(b.(Time))
+	case SecretString:
+		return a.Equal(b.(SecretString))
+	}
+	return fmt.Sprintf("%#v", a) == fmt.Sprintf("%#v", b)
+}
@@ -1,13 +1,22 @@
 package null
 
 import (
-	"bytes"
 	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"strconv"
 )
 
+// BoolMarshalAsInt makes Bool marshal to the JSON numbers 1 and 0 instead of true and false,
+// for consumers that expect numeric booleans. It also makes UnmarshalJSON and UnmarshalText accept 1 and 0
+// as well as true and false; by default numbers are rejected.
+//
+// Deprecated: set Config.BoolMarshalAsInt with SetConfig, which is safe to call while values are decoded.
+// This variable is ignored once SetConfig has been called.
+var BoolMarshalAsInt = false
+
 // Bool is a nullable bool.
 // It does not consider false values to be null.
 // It will decode to null, not false, if null.
@@ -38,22 +47,54 @@ func BoolFromPtr(b *bool) Bool {
 	return NewBool(*b, true)
 }
 
+// BoolFromResult creates a new Bool from the results of a function returning (bool, error).
+// It returns a null Bool and err if err is not nil, otherwise a valid Bool.
+func BoolFromResult(v bool, err error) (Bool, error) {
+	if err != nil {
+		return NewBool(false, false), err
+	}
+	return NewBool(v, true), nil
+}
+
+// BoolFromZero creates a new Bool that will be null if b is false.
+// It is the opposite of ValueOrZero.
+func BoolFromZero(b bool) Bool {
+	return NewBool(b, b)
+}
+
 // ValueOrZero returns the inner value if valid, otherwise false.
 func (b Bool) ValueOrZero() bool {
 	return b.Valid && b.Bool
 }
 
+// ValueOr returns the inner value if valid, otherwise def.
+func (b Bool) ValueOr(def bool) bool {
+	if !b.Valid {
+		return def
+	}
+	return b.Bool
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports number and null input.
-// 0 will not be considered a null Bool.
+// It supports bool and null input, and the numbers 1 and 0 if BoolMarshalAsInt is set.
+// false and 0 will not be considered a null Bool.
 func (b *Bool) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, nullBytes) {
+	return reportError(b.unmarshalJSON(data))
+}
+
+func (b *Bool) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if string(data) == "null" {
 		b.Valid = false
 		return nil
 	}
+	if v, ok := parseBoolInt(string(data)); ok {
+		b.SetValid(v)
+		return nil
+	}
 
 	if err := json.Unmarshal(data, &b.Bool); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		return newUnmarshalError("Bool", fmt.Errorf("couldn't unmarshal JSON: %w", err))
 	}
 
 	b.Valid = true
@@ -62,9 +103,18 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null Bool if the input is blank.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will return an error if the input is not true, false, blank, or "null",
+// or 1 or 0 if BoolMarshalAsInt is set.
 func (b *Bool) UnmarshalText(text []byte) error {
+	return reportError(b.unmarshalText(text))
+}
+
+func (b *Bool) unmarshalText(text []byte) error {
 	str := string(text)
+	if v, ok := parseBoolInt(str); ok {
+		b.SetValid(v)
+		return nil
+	}
 	switch str {
 	case "", "null":
 		b.Valid = false
@@ -74,22 +124,36 @@ func (b *Bool) UnmarshalText(text []byte) error {
 	case "false":
 		b.Bool = false
 	default:
-		return errors.New("null: invalid input for UnmarshalText:" + str)
+		return newUnmarshalError("Bool", errors.New("invalid input for UnmarshalText: "+str))
 	}
 	b.Valid = true
 	return nil
 }
 
+// parseBoolInt parses "1" and "0" if BoolMarshalAsInt is set. ok is false for any other input.
+func parseBoolInt(str string) (v, ok bool) {
+	if (str != "1" && str != "0") || !boolMarshalAsInt() {
+		return false, false
+	}
+	return str == "1", true
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Bool is null.
+// It will encode 1 or 0 instead of true or false if BoolMarshalAsInt is set.
 func (b Bool) MarshalJSON() ([]byte, error) {
-	if !b.Valid {
+	asInt := boolMarshalAsInt()
+	switch {
+	case !b.Valid:
 		return []byte("null"), nil
+	case asInt && b.Bool:
+		return []byte("1"), nil
+	case asInt:
+		return []byte("0"), nil
+	case b.Bool:
+		return []byte("true"), nil
 	}
-	if !b.Bool {
-		return []byte("false"), nil
-	}
-	return []byte("true"), nil
+	return []byte("false"), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -104,6 +168,30 @@ func (b Bool) MarshalText() ([]byte, error) {
 	return []byte("true"), nil
 }
 
+// MarshalXMLAttr implements xml.MarshalerAttr, so this Bool can be used for fields tagged with ",attr".
+// It omits the attribute if this Bool is null, instead of writing it with an empty value,
+// and otherwise writes the same value as MarshalText.
+func (b Bool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !b.Valid {
+		return xml.Attr{}, nil
+	}
+	text, err := b.MarshalText()
+	return xml.Attr{Name: name, Value: string(text)}, err
+}
+
+// Scan implements the sql.Scanner interface.
+func (b *Bool) Scan(value interface{}) error {
+	return reportError(b.scan(value))
+}
+
+func (b *Bool) scan(value interface{}) error {
+	if err := b.NullBool.Scan(scanSource(value)); err != nil {
+		b.Valid = false
+		return newScanError("Bool", value, err)
+	}
+	return nil
+}
+
 // SetValid changes this Bool's value and also sets it to be non-null.
 func (b *Bool) SetValid(v bool) {
 	b.Bool = v
@@ -118,13 +206,65 @@ func (b Bool) Ptr() *bool {
 	return &b.Bool
 }
 
+// Unwrap returns this Bool as a sql.NullBool, for code that needs the standard library type.
+func (b Bool) Unwrap() sql.NullBool {
+	return b.NullBool
+}
+
+// Reset makes this Bool null and clears its value, so a reused Bool doesn't keep previous data.
+func (b *Bool) Reset() {
+	*b = Bool{}
+}
+
 // IsZero returns true for invalid Bools, for future omitempty support (Go 1.4?)
 // A non-null Bool with a 0 value will not be considered zero.
 func (b Bool) IsZero() bool {
 	return !b.Valid
 }
 
+// AsAny returns nil if this Bool is null, otherwise its value as bool.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (b Bool) AsAny() interface{} {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bool
+}
+
 // Equal returns true if both booleans have the same value or are both null.
 func (b Bool) Equal(other Bool) bool {
 	return b.Valid == other.Valid && (!b.Valid || b.Bool == other.Bool)
 }
+
+// Compare returns -1, 0 or +1 depending on whether b is less than, equal to or greater than other.
+// A null Bool sorts before all valid ones.
+func (b Bool) Compare(other Bool) int {
+	if c, ok := compareNull(b.Valid, other.Valid); ok {
+		return c
+	}
+	return compareBool(b.Bool, other.Bool)
+}
+
+// Less returns true if b sorts before other. A null Bool sorts before all valid ones.
+func (b Bool) Less(other Bool) bool {
+	return b.Compare(other) < 0
+}
+
+// ValueEqual returns true if both booleans have the same value, treating null as false.
+// Unlike Equal, a null Bool is ValueEqual to a valid false.
+func (b Bool) ValueEqual(other Bool) bool {
+	return b.ValueOrZero() == other.ValueOrZero()
+}
+
+// JSONSchema returns the JSON Schema of this Bool's JSON encoding: a boolean or null. It is also used by FormBool.
+func (b Bool) JSONSchema() map[string]interface{} {
+	return nullableSchema("boolean")
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Bool as the Go code that creates it.
+func (b Bool) GoString() string {
+	if !b.Valid {
+		return `null.NewBool(false, false)`
+	}
+	return "null.BoolFrom(" + strconv.FormatBool(b.Bool) + ")"
+}
@@ -110,6 +110,85 @@ func TestMarshalBool(t *testing.T) {
 	assertJSONEquals(t, data, "null", "null json marshal")
 }
 
+func TestMarshalBoolAsInt(t *testing.T) {
+	defer func(prev bool) { BoolMarshalAsInt = prev }(BoolMarshalAsInt)
+	BoolMarshalAsInt = true
+
+	for _, test := range []struct {
+		in   Bool
+		want string
+	}{
+		{BoolFrom(true), "1"},
+		{BoolFrom(false), "0"},
+		{NewBool(true, false), "null"},
+	} {
+		data, err := json.Marshal(test.in)
+		maybePanic(err)
+		assertJSONEquals(t, data, test.want, "numeric json marshal")
+
+		var b Bool
+		err = json.Unmarshal(data, &b)
+		maybePanic(err)
+		if !b.Equal(test.in) {
+			t.Errorf("bad round trip of %s: %v", data, b)
+		}
+	}
+
+	// text marshaling is unaffected
+	data, err := BoolFrom(true).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "true", "text marshal")
+
+	// FormBool embeds Bool, so it follows the option too
+	data, err = json.Marshal(FormBoolFrom(true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "1", "FormBool numeric json marshal")
+
+	BoolMarshalAsInt = false
+	data, err = json.Marshal(BoolFrom(true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "true", "default json marshal")
+}
+
+func TestUnmarshalBoolNumeric(t *testing.T) {
+	var b Bool
+	if err := json.Unmarshal([]byte("1"), &b); err == nil {
+		t.Error("1 should be rejected unless BoolMarshalAsInt is set")
+	}
+	if err := b.UnmarshalText([]byte("0")); err == nil {
+		t.Error("text 0 should be rejected unless BoolMarshalAsInt is set")
+	}
+
+	defer resetConfig()
+	cfg := GetConfig()
+	cfg.BoolMarshalAsInt = true
+	SetConfig(cfg)
+
+	err := json.Unmarshal([]byte("1"), &b)
+	maybePanic(err)
+	assertBool(t, b, "unmarshal 1")
+
+	err = json.Unmarshal([]byte("0"), &b)
+	maybePanic(err)
+	assertFalseBool(t, b, "unmarshal 0")
+
+	err = b.UnmarshalText([]byte("1"))
+	maybePanic(err)
+	assertBool(t, b, "unmarshal text 1")
+
+	err = b.UnmarshalText([]byte("0"))
+	maybePanic(err)
+	assertFalseBool(t, b, "unmarshal text 0")
+
+	err = json.Unmarshal([]byte("true"), &b)
+	maybePanic(err)
+	assertBool(t, b, "unmarshal true with BoolMarshalAsInt")
+
+	if err := json.Unmarshal([]byte("2"), &b); err == nil {
+		t.Error("expected error")
+	}
+}
+
 func TestMarshalBoolText(t *testing.T) {
 	b := BoolFrom(true)
 	data, err := b.MarshalText()
@@ -216,6 +295,36 @@ func TestBoolEqual(t *testing.T) {
 	assertBoolEqualIsFalse(t, b1, b2)
 }
 
+func TestBoolValueOr(t *testing.T) {
+	valid := NewBool(false, true)
+	if valid.ValueOr(true) != false {
+		t.Error("unexpected ValueOr", valid.ValueOr(true))
+	}
+
+	invalid := NewBool(false, false)
+	if invalid.ValueOr(true) != true {
+		t.Error("unexpected ValueOr", invalid.ValueOr(true))
+	}
+}
+
+func TestBoolValueEqual(t *testing.T) {
+	null := NewBool(true, false)
+	zero := NewBool(false, true)
+	valid := NewBool(true, true)
+	if !null.ValueEqual(zero) || !zero.ValueEqual(null) {
+		t.Error("ValueEqual() of null and valid zero should be true")
+	}
+	if null.Equal(zero) {
+		t.Error("Equal() of null and valid zero should be false")
+	}
+	if !null.ValueEqual(null) || !valid.ValueEqual(valid) {
+		t.Error("ValueEqual() of the same values should be true")
+	}
+	if valid.ValueEqual(zero) || null.ValueEqual(valid) {
+		t.Error("ValueEqual() of different values should be false")
+	}
+}
+
 func assertBool(t *testing.T, b Bool, from string) {
 	if b.Bool != true {
 		t.Errorf("bad %s bool: %v ≠ %v\n", from, b.Bool, true)
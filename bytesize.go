@@ -0,0 +1,316 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Options for how ByteSize marshals.
+var (
+	// ByteSizeMarshalBinary makes ByteSize marshal with binary units such as "10MiB"
+	// instead of SI units such as "10MB". Unmarshaling accepts both regardless.
+	//
+	// Deprecated: set Config.ByteSizeMarshalBinary with SetConfig, which is safe to call while values are decoded.
+	// This variable is ignored once SetConfig has been called.
+	ByteSizeMarshalBinary = false
+	// ByteSizeMarshalAsInt makes ByteSize marshal to JSON and text as a bare number of bytes.
+	//
+	// Deprecated: set Config.ByteSizeMarshalAsInt with SetConfig, which is safe to call while values are decoded.
+	// This variable is ignored once SetConfig has been called.
+	ByteSizeMarshalAsInt = false
+)
+
+// ByteSize is a nullable, non-negative number of bytes, such as a file size or a quota.
+// It is stored in SQL as an int64 and marshaled to JSON as a string with the largest unit
+// that represents it exactly, such as "10MB", or null if null.
+// Input may be a bare number of bytes or a string with an SI unit (kB, MB, GB, TB, PB, EB, powers of 1000)
+// or a binary unit (KiB, MiB, GiB, TiB, PiB, EiB, powers of 1024). Units are case-sensitive,
+// so "10Mb" isn't mistaken for bytes, but "KB" is accepted as kB.
+type ByteSize struct {
+	Bytes int64
+	Valid bool
+}
+
+// byteUnit is a unit of ByteSize strings.
+type byteUnit struct {
+	name string
+	size int64
+}
+
+// SI and binary units, from largest to smallest.
+var (
+	siByteUnits = []byteUnit{
+		{"EB", 1e18}, {"PB", 1e15}, {"TB", 1e12}, {"GB", 1e9}, {"MB", 1e6}, {"kB", 1e3}, {"B", 1},
+	}
+	binaryByteUnits = []byteUnit{
+		{"EiB", 1 << 60}, {"PiB", 1 << 50}, {"TiB", 1 << 40}, {"GiB", 1 << 30}, {"MiB", 1 << 20}, {"KiB", 1 << 10}, {"B", 1},
+	}
+)
+
+// NewByteSize creates a new ByteSize
+func NewByteSize(b int64, valid bool) ByteSize {
+	return ByteSize{Bytes: b, Valid: valid}
+}
+
+// ByteSizeFrom creates a new ByteSize that will always be valid.
+// b is not checked for being negative.
+func ByteSizeFrom(b int64) ByteSize {
+	return NewByteSize(b, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (b ByteSize) ValueOrZero() int64 {
+	if !b.Valid {
+		return 0
+	}
+	return b.Bytes
+}
+
+// String returns the size with its unit, as it marshals, or a blank string if this ByteSize is null.
+func (b ByteSize) String() string {
+	if !b.Valid {
+		return ""
+	}
+	return formatByteSize(b.Bytes, byteSizeMarshalBinary())
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports the same input as Int and returns an error if the value is negative.
+func (b *ByteSize) Scan(value interface{}) error {
+	return reportError(b.scan(value))
+}
+
+func (b *ByteSize) scan(value interface{}) error {
+	n, err := scanInt(value)
+	if err != nil {
+		*b = ByteSize{}
+		return newScanError("ByteSize", value, err)
+	}
+	return b.set(n, nil)
+}
+
+// Value implements the driver Valuer interface.
+// It stores the number of bytes.
+func (b ByteSize) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Bytes, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string, integer and null input.
+func (b *ByteSize) UnmarshalJSON(data []byte) error {
+	return reportError(b.unmarshalJSON(data))
+}
+
+func (b *ByteSize) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*b = ByteSize{}
+		return nil
+	}
+	if len(data) == 0 || data[0] != '"' {
+		var n Int
+		err := n.unmarshalJSON(data)
+		return b.set(n, err)
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		*b = ByteSize{}
+		return newUnmarshalError("ByteSize", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	n, err := parseByteSize(str)
+	if err != nil {
+		*b = ByteSize{}
+		return newUnmarshalError("ByteSize", err)
+	}
+	*b = ByteSizeFrom(n)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null ByteSize if the input is blank.
+func (b *ByteSize) UnmarshalText(text []byte) error {
+	return reportError(b.unmarshalText(text))
+}
+
+func (b *ByteSize) unmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*b = ByteSize{}
+		return nil
+	}
+	n, err := parseByteSize(string(text))
+	if err != nil {
+		*b = ByteSize{}
+		return newUnmarshalError("ByteSize", err)
+	}
+	*b = ByteSizeFrom(n)
+	return nil
+}
+
+// set stores n if err is nil and n isn't negative, otherwise it makes b null and returns the error.
+func (b *ByteSize) set(n Int, err error) error {
+	if err == nil {
+		err = checkIntRange(n, 0, math.MaxInt64, "byte size")
+	}
+	if err != nil {
+		*b = ByteSize{}
+		return retypeError("ByteSize", err)
+	}
+	*b = NewByteSize(n.Int64, n.Valid)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this ByteSize is null, otherwise a string with a unit,
+// or the number of bytes if ByteSizeMarshalAsInt is set.
+func (b ByteSize) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	if byteSizeMarshalAsInt() {
+		return []byte(strconv.FormatInt(b.Bytes, 10)), nil
+	}
+	return []byte(strconv.Quote(formatByteSize(b.Bytes, byteSizeMarshalBinary()))), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this ByteSize is null, otherwise the same as MarshalJSON, without quotes.
+func (b ByteSize) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	if byteSizeMarshalAsInt() {
+		return []byte(strconv.FormatInt(b.Bytes, 10)), nil
+	}
+	return []byte(b.String()), nil
+}
+
+// SetValid changes this ByteSize's value and also sets it to be non-null.
+func (b *ByteSize) SetValid(n int64) {
+	b.Bytes = n
+	b.Valid = true
+}
+
+// Ptr returns a pointer to this ByteSize's value, or a nil pointer if this ByteSize is null.
+func (b ByteSize) Ptr() *int64 {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// Reset makes this ByteSize null and clears its value, so a reused ByteSize doesn't keep previous data.
+func (b *ByteSize) Reset() {
+	*b = ByteSize{}
+}
+
+// IsZero returns true for null ByteSizes.
+// A non-null ByteSize of 0 bytes will not be considered zero.
+func (b ByteSize) IsZero() bool {
+	return !b.Valid
+}
+
+// AsAny returns nil if this ByteSize is null, otherwise its number of bytes as int64.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (b ByteSize) AsAny() interface{} {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
+// Equal returns true if both sizes have the same number of bytes or are both null,
+// so "1KiB" equals "1024B".
+func (b ByteSize) Equal(other ByteSize) bool {
+	return b.Valid == other.Valid && (!b.Valid || b.Bytes == other.Bytes)
+}
+
+// JSONSchema returns the JSON Schema of this ByteSize's JSON encoding: a size with a unit,
+// or a non-negative integer if ByteSizeMarshalAsInt is set, or null.
+func (b ByteSize) JSONSchema() map[string]interface{} {
+	if byteSizeMarshalAsInt() {
+		schema := nullableSchema("integer")
+		schema["minimum"] = 0
+		return schema
+	}
+	schema := nullableSchema("string")
+	schema["pattern"] = `^[0-9]+([kKMGTPE]i?)?B$`
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this ByteSize as the Go code that creates it.
+func (b ByteSize) GoString() string {
+	if !b.Valid {
+		return `null.NewByteSize(0, false)`
+	}
+	return "null.ByteSizeFrom(" + strconv.FormatInt(b.Bytes, 10) + ")"
+}
+
+// formatByteSize formats n with the largest unit that divides it exactly.
+func formatByteSize(n int64, binary bool) string {
+	units := siByteUnits
+	if binary {
+		units = binaryByteUnits
+	}
+	for _, u := range units {
+		if n >= u.size && n%u.size == 0 {
+			return strconv.FormatInt(n/u.size, 10) + u.name
+		}
+	}
+	return strconv.FormatInt(n, 10) + "B"
+}
+
+// parseByteSize parses a number of bytes with an optional unit, separated by at most one space.
+// The number may have a fraction, such as "1.5GB", as long as the result is a whole number of bytes.
+func parseByteSize(s string) (int64, error) {
+	num := strings.TrimRight(s, "kKMGTPEiB ")
+	unit := s[len(num):]
+	if strings.HasPrefix(unit, " ") && len(unit) > 1 {
+		unit = unit[1:]
+	}
+	size, ok := byteUnitSize(unit)
+	if !ok {
+		return 0, fmt.Errorf("invalid byte size %q: unknown unit %q", s, unit)
+	}
+
+	whole, frac, hasFrac := strings.Cut(num, ".")
+	if !isDigits(whole) || (hasFrac && !isDigits(frac)) {
+		return 0, fmt.Errorf("invalid byte size %q", s)
+	}
+	r, _ := new(big.Rat).SetString(num)
+	r.Mul(r, new(big.Rat).SetInt64(size))
+	if !r.IsInt() {
+		return 0, fmt.Errorf("invalid byte size %q: not a whole number of bytes", s)
+	}
+	if !r.Num().IsInt64() {
+		return 0, fmt.Errorf("%w: byte size %q is out of range for int64", ErrOverflow, s)
+	}
+	return r.Num().Int64(), nil
+}
+
+// byteUnitSize returns the number of bytes in unit. A missing unit means bytes, and KB means kB.
+func byteUnitSize(unit string) (int64, bool) {
+	switch unit {
+	case "":
+		return 1, true
+	case "KB":
+		return 1e3, true
+	}
+	for _, units := range [][]byteUnit{siByteUnits, binaryByteUnits} {
+		for _, u := range units {
+			if u.name == unit {
+				return u.size, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,187 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestByteSizeUnmarshal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{`1024`, 1024},
+		{`0`, 0},
+		{`"512"`, 512},
+		{`"10B"`, 10},
+		{`"10kB"`, 10_000},
+		{`"10KB"`, 10_000},
+		{`"10MB"`, 10_000_000},
+		{`"10 MB"`, 10_000_000},
+		{`"1.5GB"`, 1_500_000_000},
+		{`"2TB"`, 2e12},
+		{`"10KiB"`, 10 << 10},
+		{`"10MiB"`, 10 << 20},
+		{`"1.5GiB"`, 3 << 29},
+		{`"7EiB"`, 7 << 60},
+	}
+	for _, test := range tests {
+		var b ByteSize
+		err := json.Unmarshal([]byte(test.in), &b)
+		maybePanic(err)
+		if !b.Valid || b.Bytes != test.want {
+			t.Errorf("bad %s: %#v, want %d bytes", test.in, b, test.want)
+		}
+	}
+
+	var null ByteSize
+	err := json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should be null")
+	}
+
+	for _, bad := range []string{
+		`-1`, `1.5`, `true`, `""`, `"-1KB"`, `"10Mb"`, `"10mb"`, `"10 "`, `"10  MB"`, `"MB"`,
+		`"1.5B"`, `"0.1KiB"`, `".5MB"`, `"1e3"`, `"10XB"`, `"8EiB"`,
+	} {
+		b := ByteSizeFrom(1)
+		if err := json.Unmarshal([]byte(bad), &b); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+		if b.Valid {
+			t.Errorf("%s should be null", bad)
+		}
+	}
+
+	var overflow ByteSize
+	if err := json.Unmarshal([]byte(`"8EiB"`), &overflow); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow for 8EiB, got %v", err)
+	}
+}
+
+func TestByteSizeMarshal(t *testing.T) {
+	tests := []struct {
+		in             int64
+		si, binary     string
+		siText, binTxt string
+	}{
+		{0, `"0B"`, `"0B"`, "0B", "0B"},
+		{1500, `"1500B"`, `"1500B"`, "1500B", "1500B"},
+		{10_000_000, `"10MB"`, `"10000000B"`, "10MB", "10000000B"},
+		{10 << 20, `"10485760B"`, `"10MiB"`, "10485760B", "10MiB"},
+		{4096, `"4096B"`, `"4KiB"`, "4096B", "4KiB"},
+		{8000, `"8kB"`, `"8000B"`, "8kB", "8000B"},
+	}
+	defer func() { ByteSizeMarshalBinary = false }()
+	for _, test := range tests {
+		for _, binary := range []bool{false, true} {
+			ByteSizeMarshalBinary = binary
+			want, wantText := test.si, test.siText
+			if binary {
+				want, wantText = test.binary, test.binTxt
+			}
+			b := ByteSizeFrom(test.in)
+			data, err := json.Marshal(b)
+			maybePanic(err)
+			assertJSONEquals(t, data, want, "byte size json marshal")
+			text, err := b.MarshalText()
+			maybePanic(err)
+			assertJSONEquals(t, text, wantText, "byte size text marshal")
+
+			var round ByteSize
+			err = json.Unmarshal(data, &round)
+			maybePanic(err)
+			if !round.Equal(b) {
+				t.Errorf("bad round trip of %s: %#v", data, round)
+			}
+		}
+	}
+
+	data, err := json.Marshal(NewByteSize(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null byte size json marshal")
+	text, err := NewByteSize(0, false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, text, "", "null byte size text marshal")
+}
+
+func TestByteSizeMarshalAsInt(t *testing.T) {
+	ByteSizeMarshalAsInt = true
+	defer func() { ByteSizeMarshalAsInt = false }()
+
+	data, err := json.Marshal(ByteSizeFrom(10_000_000))
+	maybePanic(err)
+	assertJSONEquals(t, data, "10000000", "byte size json marshal as int")
+	text, err := ByteSizeFrom(1024).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, text, "1024", "byte size text marshal as int")
+}
+
+func TestByteSizeText(t *testing.T) {
+	var b ByteSize
+	err := b.UnmarshalText([]byte("5MiB"))
+	maybePanic(err)
+	if !b.Equal(ByteSizeFrom(5 << 20)) {
+		t.Errorf("bad text: %#v", b)
+	}
+	err = b.UnmarshalText(nil)
+	maybePanic(err)
+	if b.Valid {
+		t.Error("blank text should be null")
+	}
+	if err := b.UnmarshalText([]byte("5 Mi")); err == nil {
+		t.Error("expected error for unknown unit")
+	}
+}
+
+func TestByteSizeScanValue(t *testing.T) {
+	var b ByteSize
+	err := b.Scan(int64(4096))
+	maybePanic(err)
+	if !b.Equal(ByteSizeFrom(4096)) {
+		t.Errorf("bad scan: %#v", b)
+	}
+	v, err := b.Value()
+	maybePanic(err)
+	if v != int64(4096) {
+		t.Errorf("bad value: %#v", v)
+	}
+
+	err = b.Scan(nil)
+	maybePanic(err)
+	if b.Valid {
+		t.Error("scanned nil should be null")
+	}
+	v, err = b.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null value = %#v, want nil", v)
+	}
+
+	if err := b.Scan(int64(-1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow scanning a negative size, got %v", err)
+	}
+	if err := b.Scan("abc"); err == nil {
+		t.Error("expected error scanning a non-number")
+	}
+}
+
+func TestByteSizeEqual(t *testing.T) {
+	var kib, bytes ByteSize
+	maybePanic(kib.UnmarshalText([]byte("1KiB")))
+	maybePanic(bytes.UnmarshalText([]byte("1024B")))
+	if !kib.Equal(bytes) {
+		t.Error("1KiB should equal 1024B")
+	}
+	if kib.Equal(ByteSizeFrom(1000)) {
+		t.Error("1KiB shouldn't equal 1kB")
+	}
+	if !NewByteSize(5, false).Equal(ByteSize{}) {
+		t.Error("null byte sizes should be equal")
+	}
+	if ByteSizeFrom(0).Equal(ByteSize{}) {
+		t.Error("0 bytes shouldn't equal null")
+	}
+}
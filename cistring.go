@@ -0,0 +1,156 @@
+package null
+
+import (
+	"context"
+	"database/sql/driver"
+	"strconv"
+	"strings"
+)
+
+// CIStringUpper makes CIString canonicalize to upper case instead of lower case.
+//
+// Deprecated: set Config.CIStringUpper with SetConfig, which is safe to call while values are decoded.
+// This variable is ignored once SetConfig has been called.
+var CIStringUpper = false
+
+// CIString is a nullable case-insensitive string, for values such as status names.
+// It is a String that is canonicalized to lower case (or upper case if CIStringUpper is set)
+// on input and output, so "Active", "active" and "ACTIVE" are all the same value.
+type CIString struct {
+	String
+}
+
+// NewCIString creates a new CIString holding the canonical form of s.
+func NewCIString(s string, valid bool) CIString {
+	return CIString{String: NewString(canonicalCase(s), valid)}
+}
+
+// CIStringFrom creates a new CIString that will always be valid.
+func CIStringFrom(s string) CIString {
+	return NewCIString(s, true)
+}
+
+// canonicalCase returns s in the case CIString canonicalizes to.
+func canonicalCase(s string) string {
+	if ciStringUpper() {
+		return strings.ToUpper(s)
+	}
+	return strings.ToLower(s)
+}
+
+// Canonical returns the canonical form of the inner value if valid, otherwise a blank string.
+func (s CIString) Canonical() string {
+	return canonicalCase(s.String.ValueOrZero())
+}
+
+// ValueOrZero returns the canonical form of the inner value if valid, otherwise a blank string.
+// It is the same as Canonical.
+func (s CIString) ValueOrZero() string {
+	return s.Canonical()
+}
+
+// SetValid changes this CIString's value to the canonical form of v and also sets it to be non-null.
+func (s *CIString) SetValid(v string) {
+	s.String.SetValid(canonicalCase(v))
+}
+
+// Ptr returns a pointer to the canonical form of this CIString's value, or a nil pointer if this CIString is null.
+func (s CIString) Ptr() *string {
+	if !s.Valid {
+		return nil
+	}
+	c := s.Canonical()
+	return &c
+}
+
+// AsAny returns nil if this CIString is null, otherwise its canonical form.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (s CIString) AsAny() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.Canonical()
+}
+
+// Scan implements the sql.Scanner interface.
+// It stores the canonical form of the value.
+// A blank string scans to a null CIString if StringEmptyIsNull is set, as it does for String.
+func (s *CIString) Scan(value interface{}) error {
+	return reportError(s.scan(value))
+}
+
+func (s *CIString) scan(value interface{}) error {
+	if err := s.String.NullString.Scan(scanSource(value)); err != nil {
+		s.Valid = false
+		return newScanError("CIString", value, err)
+	}
+	if stringEmptyIsNull() && s.String.String == "" {
+		s.Valid = false
+	}
+	s.String.String = canonicalCase(s.String.String)
+	return nil
+}
+
+// ScanContext is like Scan, but returns ctx.Err() without scanning if ctx is already done.
+// It overrides String.ScanContext, which would skip canonicalization.
+func (s *CIString) ScanContext(ctx context.Context, value interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+// It stores the canonical form of the value.
+func (s CIString) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.Canonical(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports the same input as String, and stores the canonical form of the value.
+func (s *CIString) UnmarshalJSON(data []byte) error {
+	return reportError(s.unmarshalJSON(data))
+}
+
+func (s *CIString) unmarshalJSON(data []byte) error {
+	if err := s.String.unmarshalJSON(data); err != nil {
+		return retypeError("CIString", err)
+	}
+	s.String.String = canonicalCase(s.String.String)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null CIString if the input is a blank string.
+func (s *CIString) UnmarshalText(text []byte) error {
+	*s = NewCIString(string(text), len(text) > 0)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this CIString is null, otherwise the canonical form of the value.
+func (s CIString) MarshalJSON() ([]byte, error) {
+	return NewString(s.Canonical(), s.Valid).MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this CIString is null, otherwise the canonical form of the value.
+func (s CIString) MarshalText() ([]byte, error) {
+	return []byte(s.Canonical()), nil
+}
+
+// Equal returns true if both CIStrings have the same value ignoring case, or are both null.
+func (s CIString) Equal(other CIString) bool {
+	return s.Valid == other.Valid && (!s.Valid || s.Canonical() == other.Canonical())
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this CIString as the Go code that creates it.
+func (s CIString) GoString() string {
+	if !s.Valid {
+		return `null.NewCIString("", false)`
+	}
+	return "null.CIStringFrom(" + strconv.Quote(s.Canonical()) + ")"
+}
@@ -0,0 +1,111 @@
+package null
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestCIStringEqual(t *testing.T) {
+	active := CIStringFrom("active")
+	for _, in := range []string{"Active", "ACTIVE", "aCtIvE"} {
+		if s := CIStringFrom(in); !s.Equal(active) {
+			t.Errorf("%q should equal %q", in, "active")
+		}
+		if s := (CIString{StringFrom(in)}); !s.Equal(active) {
+			t.Errorf("non-canonical %q should equal %q", in, "active")
+		}
+	}
+	if CIStringFrom("active").Equal(CIStringFrom("inactive")) {
+		t.Error("different values should not be equal")
+	}
+	if NewCIString("", false).Equal(CIStringFrom("")) {
+		t.Error("null should not equal blank")
+	}
+}
+
+func TestCIStringCanonicalizes(t *testing.T) {
+	defer func(prev bool) { CIStringUpper = prev }(CIStringUpper)
+
+	var s CIString
+	err := json.Unmarshal([]byte(`"Active"`), &s)
+	maybePanic(err)
+	if s.String.String != "active" {
+		t.Errorf("bad unmarshal: %q", s.String.String)
+	}
+
+	err = s.Scan([]byte("PENDING"))
+	maybePanic(err)
+	if s.String.String != "pending" {
+		t.Errorf("bad scan: %q", s.String.String)
+	}
+
+	data, err := json.Marshal(CIString{StringFrom("Shipped")})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"shipped"`, "ci string json marshal")
+
+	v, err := CIString{StringFrom("Shipped")}.Value()
+	maybePanic(err)
+	if v != "shipped" {
+		t.Errorf("bad value: %#v", v)
+	}
+
+	CIStringUpper = true
+	data, err = json.Marshal(CIStringFrom("Shipped"))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"SHIPPED"`, "upper ci string json marshal")
+
+	err = json.Unmarshal(nullJSON, &s)
+	maybePanic(err)
+	if s.Valid {
+		t.Error("null json should be invalid")
+	}
+	data, err = json.Marshal(s)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null ci string json marshal")
+}
+
+func TestCIStringShadowsString(t *testing.T) {
+	var s CIString
+	err := s.ScanContext(context.Background(), "ABC")
+	maybePanic(err)
+	if s.String.String != "abc" {
+		t.Errorf("bad ScanContext: %q", s.String.String)
+	}
+
+	s.SetValid("DEF")
+	if s.String.String != "def" || !s.Valid {
+		t.Errorf("bad SetValid: %#v", s)
+	}
+
+	raw := CIString{StringFrom("Shipped")}
+	if got := raw.ValueOrZero(); got != "shipped" {
+		t.Errorf("bad ValueOrZero: %q", got)
+	}
+	if p := raw.Ptr(); p == nil || *p != "shipped" {
+		t.Errorf("bad Ptr: %v", p)
+	}
+	if p := (CIString{}).Ptr(); p != nil {
+		t.Errorf("null Ptr should be nil, not %v", p)
+	}
+}
+
+func TestCIStringEmptyIsNull(t *testing.T) {
+	defer resetConfig()
+	cfg := GetConfig()
+	cfg.StringEmptyIsNull = true
+	SetConfig(cfg)
+
+	s := CIStringFrom("x")
+	err := s.Scan("")
+	maybePanic(err)
+	if s.Valid {
+		t.Error("blank scan should be null with StringEmptyIsNull")
+	}
+	s = CIStringFrom("x")
+	err = s.UnmarshalJSON(blankStringJSON)
+	maybePanic(err)
+	if s.Valid {
+		t.Error("blank JSON should be null with StringEmptyIsNull")
+	}
+}
@@ -0,0 +1,209 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Color is a nullable RGBA color, written as a #RRGGBB or #RRGGBBAA hex string.
+// It marshals to its hex string, or null if null.
+type Color struct {
+	R, G, B, A uint8
+	Valid      bool
+}
+
+// NewColor creates a new Color
+func NewColor(r, g, b, a uint8, valid bool) Color {
+	return Color{R: r, G: g, B: b, A: a, Valid: valid}
+}
+
+// ColorFrom parses a #RRGGBB or #RRGGBBAA hex string and returns a valid Color.
+// Colors without an alpha channel are opaque.
+// It returns an error and a null Color if s is malformed.
+func ColorFrom(s string) (Color, error) {
+	c, err := parseColor(s)
+	if err != nil {
+		return Color{}, newUnmarshalError("Color", err)
+	}
+	return c, nil
+}
+
+// MustColor is like ColorFrom but panics if s is malformed.
+// It simplifies initializing fixtures and package-level variables, like regexp.MustCompile.
+func MustColor(s string) Color {
+	v, err := ColorFrom(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns the color as #rrggbb if it is opaque, otherwise as #rrggbbaa.
+// It returns a blank string if this Color is null.
+func (c Color) String() string {
+	if !c.Valid {
+		return ""
+	}
+	rgba := []byte{c.R, c.G, c.B, c.A}
+	if c.A == 0xff {
+		rgba = rgba[:3]
+	}
+	return "#" + hex.EncodeToString(rgba)
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil input.
+func (c *Color) Scan(value interface{}) error {
+	return reportError(c.scan(value))
+}
+
+func (c *Color) scan(value interface{}) error {
+	var err error
+	switch x := scanSource(value).(type) {
+	case nil:
+		*c = Color{}
+		return nil
+	case string:
+		*c, err = parseColor(x)
+	case []byte:
+		*c, err = parseColor(string(x))
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		*c = Color{}
+		return newScanError("Color", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It stores the hex string.
+func (c Color) Value() (driver.Value, error) {
+	if !c.Valid {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (c *Color) UnmarshalJSON(data []byte) error {
+	return reportError(c.unmarshalJSON(data))
+}
+
+func (c *Color) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*c = Color{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		*c = Color{}
+		return newUnmarshalError("Color", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	parsed, err := parseColor(str)
+	if err != nil {
+		*c = Color{}
+		return newUnmarshalError("Color", err)
+	}
+	*c = parsed
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Color if the input is blank or "null".
+func (c *Color) UnmarshalText(text []byte) error {
+	return reportError(c.unmarshalText(text))
+}
+
+func (c *Color) unmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		*c = Color{}
+		return nil
+	}
+	parsed, err := parseColor(str)
+	if err != nil {
+		*c = Color{}
+		return newUnmarshalError("Color", err)
+	}
+	*c = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Color is null.
+func (c Color) MarshalJSON() ([]byte, error) {
+	if !c.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + c.String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Color is null.
+func (c Color) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// Reset makes this Color null and clears its value, so a reused Color doesn't keep previous data.
+func (c *Color) Reset() {
+	*c = Color{}
+}
+
+// IsZero returns true for null Colors.
+func (c Color) IsZero() bool {
+	return !c.Valid
+}
+
+// AsAny returns nil if this Color is null, otherwise the #rrggbb or #rrggbbaa string.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (c Color) AsAny() interface{} {
+	if !c.Valid {
+		return nil
+	}
+	return c.String()
+}
+
+// Equal returns true if both colors have the same channels or are both null.
+func (c Color) Equal(other Color) bool {
+	return c.Valid == other.Valid && (!c.Valid || (c.R == other.R && c.G == other.G && c.B == other.B && c.A == other.A))
+}
+
+// parseColor parses a #RRGGBB or #RRGGBBAA hex string.
+func parseColor(s string) (Color, error) {
+	if len(s) != 7 && len(s) != 9 || s[0] != '#' {
+		return Color{}, errors.New("invalid color " + strconv.Quote(s))
+	}
+	rgba, err := hex.DecodeString(s[1:])
+	if err != nil {
+		return Color{}, errors.New("invalid color " + strconv.Quote(s))
+	}
+	if len(rgba) == 3 {
+		rgba = append(rgba, 0xff)
+	}
+	return NewColor(rgba[0], rgba[1], rgba[2], rgba[3], true), nil
+}
+
+// JSONSchema returns the JSON Schema of this Color's JSON encoding: a #rrggbb or #rrggbbaa string or null.
+func (c Color) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("string")
+	schema["pattern"] = "^#[0-9a-f]{6}([0-9a-f]{2})?$"
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Color as the Go code that creates it.
+func (c Color) GoString() string {
+	if !c.Valid {
+		return `null.NewColor(0, 0, 0, 0, false)`
+	}
+	return fmt.Sprintf("null.NewColor(0x%02x, 0x%02x, 0x%02x, 0x%02x, true)", c.R, c.G, c.B, c.A)
+}
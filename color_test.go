@@ -0,0 +1,146 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestColorFrom(t *testing.T) {
+	c, err := ColorFrom("#FF8000")
+	maybePanic(err)
+	if want := NewColor(0xff, 0x80, 0x00, 0xff, true); c != want {
+		t.Errorf("bad ColorFrom(): %+v ≠ %+v", c, want)
+	}
+
+	c, err = ColorFrom("#ff800080")
+	maybePanic(err)
+	if want := NewColor(0xff, 0x80, 0x00, 0x80, true); c != want {
+		t.Errorf("bad ColorFrom(): %+v ≠ %+v", c, want)
+	}
+}
+
+func TestMustColor(t *testing.T) {
+	if c := MustColor("#ff8000"); c != NewColor(0xff, 0x80, 0x00, 0xff, true) {
+		t.Errorf("bad MustColor(): %+v", c)
+	}
+	assertPanics(t, func() { MustColor("orange") }, "MustColor(orange)")
+}
+
+func TestColorInvalid(t *testing.T) {
+	for _, input := range []string{"#fff", "ff8000", "#ff800", "#ff80000", "#gg8000", "#ff8000800", "##ff8000"} {
+		c, err := ColorFrom(input)
+		var unmarshalErr *UnmarshalError
+		if !errors.As(err, &unmarshalErr) || unmarshalErr.Type != "Color" {
+			t.Errorf("expected UnmarshalError for %q, not %v", input, err)
+		}
+		if c.Valid {
+			t.Errorf("%q should produce a null Color", input)
+		}
+
+		c = NewColor(1, 2, 3, 4, true)
+		if err := json.Unmarshal([]byte(`"`+input+`"`), &c); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+		if c.Valid {
+			t.Errorf("%q should produce a null Color", input)
+		}
+	}
+}
+
+func TestMarshalColor(t *testing.T) {
+	for _, test := range []struct{ in, out string }{
+		{"#FF8000", "#ff8000"},
+		{"#ff800080", "#ff800080"},
+		{"#000000ff", "#000000"},
+	} {
+		var c Color
+		err := json.Unmarshal([]byte(`"`+test.in+`"`), &c)
+		maybePanic(err)
+		data, err := json.Marshal(c)
+		maybePanic(err)
+		assertJSONEquals(t, data, `"`+test.out+`"`, "json marshal")
+		data, err = c.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, data, test.out, "text marshal")
+	}
+
+	data, err := json.Marshal(Color{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	var null Color
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should produce a null Color")
+	}
+}
+
+func TestTextUnmarshalColor(t *testing.T) {
+	var c Color
+	err := c.UnmarshalText([]byte("#010203"))
+	maybePanic(err)
+	if want := NewColor(1, 2, 3, 0xff, true); c != want {
+		t.Errorf("bad UnmarshalText(): %+v ≠ %+v", c, want)
+	}
+
+	err = c.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if c.Valid {
+		t.Error("blank text should produce a null Color")
+	}
+
+	if err := c.UnmarshalText([]byte("red")); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestColorScanValue(t *testing.T) {
+	var c Color
+	err := c.Scan("#ff8000")
+	maybePanic(err)
+	if v, err := c.Value(); v != "#ff8000" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	err = c.Scan([]byte("#ff800080"))
+	maybePanic(err)
+	if v, err := c.Value(); v != "#ff800080" || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	err = c.Scan(nil)
+	maybePanic(err)
+	if v, err := c.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	if err := c.Scan("#xyz"); err == nil {
+		t.Error("expected error")
+	}
+	if err := c.Scan(int64(1)); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestColorEqual(t *testing.T) {
+	a, _ := ColorFrom("#ff8000")
+	b, _ := ColorFrom("#FF8000FF")
+	if !a.Equal(b) {
+		t.Error("same colors should be Equal")
+	}
+	c, _ := ColorFrom("#ff800080")
+	if a.Equal(c) {
+		t.Error("different colors should not be Equal")
+	}
+	if !NewColor(1, 2, 3, 4, false).Equal(Color{}) {
+		t.Error("null Colors should be Equal")
+	}
+	if a.Equal(Color{}) {
+		t.Error("valid and null Colors should not be Equal")
+	}
+	if a.IsZero() || !(Color{}).IsZero() {
+		t.Error("unexpected IsZero")
+	}
+}
@@ -0,0 +1,186 @@
+package null
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Config holds the options that change how the types in this package encode and decode values.
+// Each field has the same meaning as the package variable of the same name.
+//
+// Assigning those variables while values are encoded or decoded in other goroutines is a data race.
+// SetConfig replaces all options at once instead, and is safe to call at any time:
+// every encoding or decoding sees the options of a single Config.
+// Once SetConfig has been called, the package variables are ignored, which is why they are deprecated.
+// The Now and OnUnmarshalError hooks are not options and stay package variables.
+type Config struct {
+	NumberEmptyIsNull       bool
+	IntMarshalLargeAsString bool
+	IntAllowExponent        bool
+	StringEmptyIsNull       bool
+	BoolMarshalAsInt        bool
+	EnumIntMarshalCode      bool
+	MonthMarshalAsName      bool
+	CIStringUpper           bool
+	TimestampTextAsRFC3339  bool
+	ByteSizeMarshalBinary   bool
+	ByteSizeMarshalAsInt    bool
+	FloatLocale             Locale
+
+	// TimeLayouts are the layouts Time accepts, see SetTimeLayouts. If empty, the default layouts are used.
+	TimeLayouts []string
+
+	PercentMin     float64
+	PercentMax     float64
+	PercentEpsilon float64
+}
+
+// DefaultConfig returns the options this package uses unless they are changed.
+func DefaultConfig() Config {
+	return Config{
+		TimeLayouts:    cloneLayouts(defaultTimeLayouts),
+		PercentMin:     0,
+		PercentMax:     100,
+		PercentEpsilon: 1e-9,
+	}
+}
+
+// config holds the *Config passed to SetConfig, or a nil *Config if it hasn't been called.
+// Readers load it without locking; writers hold configMu, so a change based on
+// the current Config, such as SetTimeLayouts, doesn't undo one made concurrently.
+var (
+	config   atomic.Value
+	configMu sync.Mutex
+)
+
+// SetConfig replaces the options used by every type in this package.
+// It is safe to call while values are being encoded and decoded in other goroutines.
+// Change a single option by modifying the result of GetConfig.
+func SetConfig(c Config) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	storeConfig(c)
+}
+
+// storeConfig is SetConfig for callers holding configMu.
+func storeConfig(c Config) {
+	c.TimeLayouts = cloneLayouts(c.TimeLayouts)
+	config.Store(&c)
+}
+
+// GetConfig returns the options currently in use: the Config last passed to SetConfig,
+// or the values of the package variables if SetConfig hasn't been called.
+func GetConfig() Config {
+	if c := storedConfig(); c != nil {
+		cfg := *c
+		cfg.TimeLayouts = cloneLayouts(c.TimeLayouts)
+		return cfg
+	}
+	return Config{
+		NumberEmptyIsNull:       NumberEmptyIsNull,
+		IntMarshalLargeAsString: IntMarshalLargeAsString,
+		IntAllowExponent:        IntAllowExponent,
+		StringEmptyIsNull:       StringEmptyIsNull,
+		BoolMarshalAsInt:        BoolMarshalAsInt,
+		EnumIntMarshalCode:      EnumIntMarshalCode,
+		MonthMarshalAsName:      MonthMarshalAsName,
+		CIStringUpper:           CIStringUpper,
+		TimestampTextAsRFC3339:  TimestampTextAsRFC3339,
+		ByteSizeMarshalBinary:   ByteSizeMarshalBinary,
+		ByteSizeMarshalAsInt:    ByteSizeMarshalAsInt,
+		FloatLocale:             FloatLocale,
+		TimeLayouts:             cloneLayouts(timeLayouts),
+		PercentMin:              PercentMin,
+		PercentMax:              PercentMax,
+		PercentEpsilon:          PercentEpsilon,
+	}
+}
+
+// storedConfig returns the Config passed to SetConfig, which must not be modified, or nil if it hasn't been called.
+func storedConfig() *Config {
+	c, _ := config.Load().(*Config)
+	return c
+}
+
+// option returns the field of the stored Config that get selects, or global, the package variable
+// holding the same option, if SetConfig hasn't been called. Reading a single option this way
+// doesn't copy the whole Config on every encode and decode.
+func option[T any](global T, get func(*Config) T) T {
+	if c := storedConfig(); c != nil {
+		return get(c)
+	}
+	return global
+}
+
+func numberEmptyIsNull() bool {
+	return option(NumberEmptyIsNull, func(c *Config) bool { return c.NumberEmptyIsNull })
+}
+
+func intMarshalLargeAsString() bool {
+	return option(IntMarshalLargeAsString, func(c *Config) bool { return c.IntMarshalLargeAsString })
+}
+
+func intAllowExponent() bool {
+	return option(IntAllowExponent, func(c *Config) bool { return c.IntAllowExponent })
+}
+
+func stringEmptyIsNull() bool {
+	return option(StringEmptyIsNull, func(c *Config) bool { return c.StringEmptyIsNull })
+}
+
+func boolMarshalAsInt() bool {
+	return option(BoolMarshalAsInt, func(c *Config) bool { return c.BoolMarshalAsInt })
+}
+
+func enumIntMarshalCode() bool {
+	return option(EnumIntMarshalCode, func(c *Config) bool { return c.EnumIntMarshalCode })
+}
+
+func monthMarshalAsName() bool {
+	return option(MonthMarshalAsName, func(c *Config) bool { return c.MonthMarshalAsName })
+}
+
+func ciStringUpper() bool {
+	return option(CIStringUpper, func(c *Config) bool { return c.CIStringUpper })
+}
+
+func timestampTextAsRFC3339() bool {
+	return option(TimestampTextAsRFC3339, func(c *Config) bool { return c.TimestampTextAsRFC3339 })
+}
+
+func byteSizeMarshalBinary() bool {
+	return option(ByteSizeMarshalBinary, func(c *Config) bool { return c.ByteSizeMarshalBinary })
+}
+
+func byteSizeMarshalAsInt() bool {
+	return option(ByteSizeMarshalAsInt, func(c *Config) bool { return c.ByteSizeMarshalAsInt })
+}
+
+func floatLocale() Locale {
+	return option(FloatLocale, func(c *Config) Locale { return c.FloatLocale })
+}
+
+// currentTimeLayouts returns the layouts Time accepts, which must not be modified.
+func currentTimeLayouts() []string {
+	return option(timeLayouts, func(c *Config) []string { return c.TimeLayouts })
+}
+
+// percentBounds returns the smallest and largest values Percent accepts.
+func percentBounds() (lo, hi float64) {
+	if c := storedConfig(); c != nil {
+		return c.PercentMin, c.PercentMax
+	}
+	return PercentMin, PercentMax
+}
+
+func percentEpsilon() float64 {
+	return option(PercentEpsilon, func(c *Config) float64 { return c.PercentEpsilon })
+}
+
+// cloneLayouts returns a copy of layouts, or nil if it is empty.
+func cloneLayouts(layouts []string) []string {
+	if len(layouts) == 0 {
+		return nil
+	}
+	return append([]string(nil), layouts...)
+}
@@ -0,0 +1,93 @@
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// resetConfig makes the package variables take effect again, undoing SetConfig.
+func resetConfig() {
+	config.Store((*Config)(nil))
+}
+
+func TestDefaultConfig(t *testing.T) {
+	if got, want := GetConfig(), DefaultConfig(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetConfig() = %#v, want %#v", got, want)
+	}
+}
+
+func TestSetConfig(t *testing.T) {
+	defer resetConfig()
+	defer func(prev bool) { BoolMarshalAsInt = prev }(BoolMarshalAsInt)
+
+	cfg := DefaultConfig()
+	cfg.BoolMarshalAsInt = true
+	cfg.PercentMax = 1
+	SetConfig(cfg)
+
+	data, err := json.Marshal(BoolFrom(true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "1", "bool json marshal with Config")
+	var p Percent
+	if err := json.Unmarshal([]byte("50"), &p); err == nil {
+		t.Error("expected error for a Percent above the configured PercentMax")
+	}
+
+	// the package variables are ignored from now on
+	BoolMarshalAsInt = false
+	data, err = json.Marshal(BoolFrom(true))
+	maybePanic(err)
+	assertJSONEquals(t, data, "1", "bool json marshal ignoring the variable")
+
+	got := GetConfig()
+	if !reflect.DeepEqual(got, cfg) {
+		t.Errorf("GetConfig() = %#v, want %#v", got, cfg)
+	}
+	got.TimeLayouts[0] = "changed"
+	if GetConfig().TimeLayouts[0] == "changed" {
+		t.Error("GetConfig() should copy TimeLayouts")
+	}
+
+	SetTimeLayouts([]string{"02/01/2006"})
+	if got := GetConfig(); len(got.TimeLayouts) != 1 || !got.BoolMarshalAsInt {
+		t.Errorf("SetTimeLayouts should update the Config: %#v", got)
+	}
+	var ti Time
+	err = ti.UnmarshalText([]byte("21/12/2012"))
+	maybePanic(err)
+	SetTimeLayouts(nil)
+	err = ti.UnmarshalText([]byte(timeString1))
+	maybePanic(err)
+	assertTime(t, ti, "default layouts from Config")
+}
+
+func TestConfigRace(t *testing.T) {
+	defer resetConfig()
+
+	var wg sync.WaitGroup
+	for n := 0; n < 4; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				data, err := json.Marshal(BoolFrom(true))
+				maybePanic(err)
+				if s := string(data); s != "true" && s != "1" {
+					t.Errorf("bad bool json marshal: %s", s)
+				}
+				var s String
+				err = json.Unmarshal([]byte(`""`), &s)
+				maybePanic(err)
+			}
+		}()
+	}
+	for i := 0; i < 200; i++ {
+		cfg := DefaultConfig()
+		cfg.BoolMarshalAsInt = i%2 == 0
+		cfg.StringEmptyIsNull = i%2 == 0
+		SetConfig(cfg)
+	}
+	wg.Wait()
+}
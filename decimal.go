@@ -0,0 +1,225 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is a nullable decimal number kept as text, for NUMERIC columns such as money amounts
+// that must not be rounded through a float64.
+// Its value is a decimal literal: an optional minus sign, digits, and an optional fraction, such as -12.50.
+// It marshals to a bare JSON number, or null if null.
+type Decimal struct {
+	Decimal string
+	Valid   bool
+}
+
+// DecimalFrom validates s and returns a valid Decimal.
+// It returns an error and a null Decimal if s is not a decimal literal.
+func DecimalFrom(s string) (Decimal, error) {
+	d, err := parseDecimal(s)
+	if err != nil {
+		return Decimal{}, newUnmarshalError("Decimal", err)
+	}
+	return d, nil
+}
+
+// MustDecimal is like DecimalFrom but panics if s is not a decimal literal.
+// It simplifies initializing fixtures and package-level variables, like regexp.MustCompile.
+func MustDecimal(s string) Decimal {
+	d, err := DecimalFrom(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// String returns the decimal as written, or a blank string if this Decimal is null.
+func (d Decimal) String() string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Decimal
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports []byte, string, int64 and nil input. Floats are rejected, as they may already be rounded.
+func (d *Decimal) Scan(value interface{}) error {
+	return reportError(d.scan(value))
+}
+
+func (d *Decimal) scan(value interface{}) error {
+	var err error
+	switch v := scanSource(value).(type) {
+	case nil:
+		*d = Decimal{}
+		return nil
+	case int64:
+		*d = Decimal{Decimal: strconv.FormatInt(v, 10), Valid: true}
+	case []byte:
+		*d, err = parseDecimal(string(v))
+	case string:
+		*d, err = parseDecimal(v)
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		*d = Decimal{}
+		return newScanError("Decimal", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It passes the decimal to the driver as a string.
+func (d Decimal) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Decimal, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input. Numbers with an exponent are rejected.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	return reportError(d.unmarshalJSON(data))
+}
+
+func (d *Decimal) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*d = Decimal{}
+		return nil
+	}
+
+	str := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &str); err != nil {
+			*d = Decimal{}
+			return newUnmarshalError("Decimal", fmt.Errorf("couldn't unmarshal decimal string: %w", err))
+		}
+	}
+	parsed, err := parseDecimal(str)
+	if err != nil {
+		*d = Decimal{}
+		return newUnmarshalError("Decimal", err)
+	}
+	*d = parsed
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Decimal if the input is blank.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	return reportError(d.unmarshalText(text))
+}
+
+func (d *Decimal) unmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Decimal{}
+		return nil
+	}
+	parsed, err := parseDecimal(string(text))
+	if err != nil {
+		*d = Decimal{}
+		return newUnmarshalError("Decimal", err)
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Decimal is null, otherwise a bare number written as stored.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return json.RawMessage(d.Decimal), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Decimal is null.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// Reset makes this Decimal null and clears its value, so a reused Decimal doesn't keep previous data.
+func (d *Decimal) Reset() {
+	*d = Decimal{}
+}
+
+// IsZero returns true for null Decimals.
+// A non-null Decimal with a 0 value will not be considered zero.
+func (d Decimal) IsZero() bool {
+	return !d.Valid
+}
+
+// AsAny returns nil if this Decimal is null, otherwise its value as json.Number.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (d Decimal) AsAny() interface{} {
+	if !d.Valid {
+		return nil
+	}
+	return json.Number(d.Decimal)
+}
+
+// Equal returns true if both decimals have the same value or are both null.
+// Trailing zeros in the fraction don't matter, so 1.50 and 1.5 are Equal.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Valid == other.Valid && (!d.Valid || normalizeDecimal(d.Decimal) == normalizeDecimal(other.Decimal))
+}
+
+// Cmp returns -1, 0 or +1 depending on whether d is less than, equal to or greater than other.
+// A null Decimal sorts before all valid ones.
+func (d Decimal) Cmp(other Decimal) int {
+	if c, ok := compareNull(d.Valid, other.Valid); ok {
+		return c
+	}
+	a, _ := new(big.Rat).SetString(d.Decimal)
+	b, _ := new(big.Rat).SetString(other.Decimal)
+	if a == nil || b == nil {
+		// only possible if the Decimal field was set to something invalid directly
+		return strings.Compare(d.Decimal, other.Decimal)
+	}
+	return a.Cmp(b)
+}
+
+// JSONSchema returns the JSON Schema of this Decimal's JSON encoding: a number or null.
+func (d Decimal) JSONSchema() map[string]interface{} {
+	return nullableSchema("number")
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Decimal as the Go code that creates it.
+func (d Decimal) GoString() string {
+	if !d.Valid {
+		return "null.Decimal{}"
+	}
+	return "null.MustDecimal(" + strconv.Quote(d.Decimal) + ")"
+}
+
+// parseDecimal validates a decimal literal.
+func parseDecimal(s string) (Decimal, error) {
+	if !isValidNumber(s) || strings.ContainsAny(s, "eE") {
+		return Decimal{}, errors.New("invalid decimal " + strconv.Quote(s))
+	}
+	return Decimal{Decimal: s, Valid: true}, nil
+}
+
+// normalizeDecimal strips trailing zeros from the fraction of a valid decimal literal,
+// and the sign from zero, so equal values are written the same way.
+func normalizeDecimal(s string) string {
+	if strings.IndexByte(s, '.') >= 0 {
+		s = strings.TrimRight(s, "0")
+		s = strings.TrimSuffix(s, ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
@@ -0,0 +1,98 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecimalJSON(t *testing.T) {
+	for _, in := range []string{`1.50`, `"1.50"`} {
+		var d Decimal
+		err := json.Unmarshal([]byte(in), &d)
+		maybePanic(err)
+		if !d.Valid || d.Decimal != "1.50" {
+			t.Errorf("bad unmarshal of %s: %#v", in, d)
+		}
+		data, err := json.Marshal(d)
+		maybePanic(err)
+		assertJSONEquals(t, data, `1.50`, "decimal json marshal")
+	}
+
+	var null Decimal
+	err := json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	data, err := json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, `null`, "null decimal json marshal")
+
+	for _, bad := range []string{`1e3`, `"1.5.0"`, `"NaN"`, `"01"`, `".5"`, `"1."`, `true`, `""`} {
+		d := MustDecimal("1")
+		if err := json.Unmarshal([]byte(bad), &d); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+		if d.Valid {
+			t.Errorf("%s should be null", bad)
+		}
+	}
+}
+
+func TestDecimalScanValue(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want string
+	}{
+		{[]byte("-12345.6789"), "-12345.6789"},
+		{"0.10", "0.10"},
+		{int64(42), "42"},
+	}
+	for _, test := range tests {
+		var d Decimal
+		err := d.Scan(test.in)
+		maybePanic(err)
+		if !d.Valid || d.Decimal != test.want {
+			t.Errorf("Scan(%#v) = %#v, want %s", test.in, d, test.want)
+		}
+		v, err := d.Value()
+		maybePanic(err)
+		if v != test.want {
+			t.Errorf("bad value: %#v", v)
+		}
+	}
+
+	var d Decimal
+	err := d.Scan(nil)
+	maybePanic(err)
+	if v, err := d.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+	if err := d.Scan(1.5); err == nil {
+		t.Error("expected error scanning a float")
+	}
+}
+
+func TestDecimalEqualCmp(t *testing.T) {
+	tests := []struct {
+		a, b Decimal
+		cmp  int
+	}{
+		{MustDecimal("1.50"), MustDecimal("1.5"), 0},
+		{MustDecimal("2"), MustDecimal("2.000"), 0},
+		{MustDecimal("-0.0"), MustDecimal("0"), 0},
+		{MustDecimal("10"), MustDecimal("1.0"), 1},
+		{MustDecimal("-1.5"), MustDecimal("1.5"), -1},
+		{MustDecimal("0.1"), MustDecimal("0.10000000000000000001"), -1},
+		{Decimal{}, MustDecimal("-100"), -1},
+		{Decimal{}, Decimal{}, 0},
+	}
+	for _, test := range tests {
+		if got := test.a.Cmp(test.b); got != test.cmp {
+			t.Errorf("%v.Cmp(%v) = %d, want %d", test.a, test.b, got, test.cmp)
+		}
+		if got := test.a.Equal(test.b); got != (test.cmp == 0) {
+			t.Errorf("%v.Equal(%v) = %v", test.a, test.b, got)
+		}
+	}
+	if MustDecimal("10").Equal(MustDecimal("1")) {
+		t.Error("trailing zeros of an integer are significant")
+	}
+}
@@ -0,0 +1,116 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// DecodeSlice decodes a JSON array of values, such as the types in this package, from dec.
+// Elements are decoded one at a time straight into the result with T's UnmarshalJSON,
+// without copying them to an intermediate buffer, so the array is streamed instead of being held in memory as a whole.
+// A JSON null decodes to a nil slice.
+//
+//	ints, err := null.DecodeSlice[null.Int](dec)
+func DecodeSlice[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](dec *json.Decoder) ([]T, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't decode slice: %w", err)
+	}
+	if tok == nil {
+		return nil, nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return nil, errors.New("null: couldn't decode slice: input is not an array")
+	}
+
+	out := []T{}
+	for i := 0; dec.More(); i++ {
+		var zero T
+		out = append(out, zero)
+		if err := dec.Decode(PT(&out[i])); err != nil {
+			return nil, fmt.Errorf("null: couldn't decode slice element %d: %w", i, err)
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("null: couldn't decode slice: %w", err)
+	}
+	return out, nil
+}
+
+// UnmarshalInts decodes a JSON array of numbers into a slice of Ints in a single pass, for bulk input.
+// JSON nulls in the array decode to null Ints, and the slice is allocated once up front.
+// Elements accept the same input as Int.UnmarshalJSON. A JSON null decodes to a nil slice.
+func UnmarshalInts(data []byte) ([]Int, error) {
+	ints, err := unmarshalInts(data)
+	if err != nil {
+		return nil, reportError(fmt.Errorf("null: couldn't unmarshal ints: %w", err))
+	}
+	return ints, nil
+}
+
+// unmarshalInts is UnmarshalInts without the "null: couldn't unmarshal ints" prefix on errors,
+// for callers that wrap them themselves.
+func unmarshalInts(data []byte) ([]Int, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil {
+		return nil, checkEOF(dec)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return nil, errors.New("input is not an array")
+	}
+
+	// every element but the last is followed by a comma, so this is enough unless strings contain commas
+	out := make([]Int, 0, bytes.Count(data, []byte{','})+1)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", len(out), err)
+		}
+		var i Int
+		switch v := tok.(type) {
+		case nil:
+		case json.Number:
+			if i.Int64, err = strconv.ParseInt(string(v), 10, 64); err == nil {
+				i.Valid = true
+			} else {
+				err = i.unmarshalJSON([]byte(v))
+			}
+		case json.Delim:
+			err = fmt.Errorf("unexpected %v", v)
+		default:
+			// strings and bools take the slow path, to get Int's handling and errors
+			var raw []byte
+			if raw, err = json.Marshal(v); err == nil {
+				err = i.unmarshalJSON(raw)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", len(out), err)
+		}
+		out = append(out, i)
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return out, checkEOF(dec)
+}
+
+// checkEOF returns an error if dec has input left after the value it decoded.
+func checkEOF(dec *json.Decoder) error {
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("unexpected data after array")
+	}
+	return nil
+}
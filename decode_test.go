@@ -0,0 +1,91 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDecodeSlice(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`[12345, null, "12345"] ["test", null]`))
+	ints, err := DecodeSlice[Int](dec)
+	maybePanic(err)
+	if len(ints) != 3 {
+		t.Fatalf("bad length: %d ≠ 3", len(ints))
+	}
+	assertInt(t, ints[0], "first element")
+	assertNullInt(t, ints[1], "second element")
+	assertInt(t, ints[2], "third element")
+
+	strs, err := DecodeSlice[String](dec)
+	maybePanic(err)
+	if len(strs) != 2 {
+		t.Fatalf("bad length: %d ≠ 2", len(strs))
+	}
+	assertStr(t, strs[0], "first element")
+	assertNullStr(t, strs[1], "second element")
+}
+
+func TestDecodeSliceEmptyAndNull(t *testing.T) {
+	empty, err := DecodeSlice[Int](json.NewDecoder(strings.NewReader(`[]`)))
+	maybePanic(err)
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("empty array should decode to an empty slice, not %#v", empty)
+	}
+
+	null, err := DecodeSlice[Int](json.NewDecoder(strings.NewReader(`null`)))
+	maybePanic(err)
+	if null != nil {
+		t.Errorf("null should decode to a nil slice, not %#v", null)
+	}
+}
+
+func TestDecodeSliceErrors(t *testing.T) {
+	for _, input := range []string{`{"a": 1}`, `[1, true]`, `[1, 2`, `12345`, ``} {
+		if _, err := DecodeSlice[Int](json.NewDecoder(strings.NewReader(input))); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestUnmarshalInts(t *testing.T) {
+	ints, err := UnmarshalInts([]byte(`[1,null,3]`))
+	maybePanic(err)
+	want := []Int{IntFrom(1), NewInt(0, false), IntFrom(3)}
+	if len(ints) != len(want) {
+		t.Fatalf("bad length: %d ≠ %d", len(ints), len(want))
+	}
+	for i := range want {
+		if !ints[i].Equal(want[i]) {
+			t.Errorf("element %d: %#v ≠ %#v", i, ints[i], want[i])
+		}
+	}
+
+	mixed, err := UnmarshalInts([]byte(` [12345, "12345", -0] `))
+	maybePanic(err)
+	assertInt(t, mixed[0], "number element")
+	assertInt(t, mixed[1], "string element")
+	if !mixed[2].Equal(IntFrom(0)) {
+		t.Errorf("bad zero element: %#v", mixed[2])
+	}
+
+	empty, err := UnmarshalInts([]byte(`[]`))
+	maybePanic(err)
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("empty array should decode to an empty slice, not %#v", empty)
+	}
+
+	null, err := UnmarshalInts(nullJSON)
+	maybePanic(err)
+	if null != nil {
+		t.Errorf("null should decode to a nil slice, not %#v", null)
+	}
+}
+
+func TestUnmarshalIntsErrors(t *testing.T) {
+	for _, input := range []string{`{"a": 1}`, `[1, true]`, `[1.5]`, `[[1]]`, `[1, 2`, `[1] [2]`, `12345`, ``} {
+		if _, err := UnmarshalInts([]byte(input)); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
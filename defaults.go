@@ -0,0 +1,55 @@
+package null
+
+import (
+	"errors"
+	"reflect"
+)
+
+// nullableType is the reflect.Type of the Nullable interface.
+var nullableType = reflect.TypeOf((*Nullable)(nil)).Elem()
+
+// ApplyDefaults fills the null fields of the struct target points to from defaults,
+// a struct of the same type or a pointer to one.
+// Only exported fields whose type implements Nullable, such as every type in this package, are considered.
+// A field is filled if it IsZero in target, so valid fields in target are never changed.
+// Pointer fields that are nil in target are filled with a pointer to a copy of the default, if it isn't nil too.
+func ApplyDefaults(target, defaults interface{}) error {
+	rt := reflect.ValueOf(target)
+	if rt.Kind() != reflect.Ptr || rt.IsNil() || rt.Elem().Kind() != reflect.Struct {
+		return errors.New("null: ApplyDefaults needs a pointer to a struct as target")
+	}
+	t := rt.Elem()
+	d := indirectStruct(defaults)
+	if !d.IsValid() || d.Type() != t.Type() {
+		return errors.New("null: ApplyDefaults needs defaults of the same struct type as target")
+	}
+
+	typ := t.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" || !field.Type.Implements(nullableType) {
+			continue
+		}
+		applyDefault(t.Field(i), d.Field(i))
+	}
+	return nil
+}
+
+// applyDefault sets target to def if target IsZero.
+func applyDefault(target, def reflect.Value) {
+	if target.Kind() != reflect.Ptr {
+		if target.Interface().(Nullable).IsZero() {
+			target.Set(def)
+		}
+		return
+	}
+	if def.IsNil() {
+		return
+	}
+	if target.IsNil() {
+		target.Set(reflect.New(target.Type().Elem()))
+	} else if !target.Interface().(Nullable).IsZero() {
+		return
+	}
+	target.Elem().Set(def.Elem())
+}
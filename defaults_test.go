@@ -0,0 +1,106 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type defaultsConfig struct {
+	Host    String
+	Port    Int
+	Ratio   Float
+	Debug   Bool
+	Version Semver
+	Plain   string
+	secret  String
+}
+
+func TestApplyDefaults(t *testing.T) {
+	defaults := defaultsConfig{
+		Host:    StringFrom("localhost"),
+		Port:    IntFrom(8080),
+		Ratio:   FloatFrom(0.5),
+		Debug:   BoolFrom(true),
+		Version: Semver{Major: 1, Valid: true},
+		Plain:   "default",
+		secret:  StringFrom("default"),
+	}
+
+	var cfg defaultsConfig
+	err := json.Unmarshal([]byte(`{"Host": "example.com", "Port": null, "Debug": false}`), &cfg)
+	maybePanic(err)
+	err = ApplyDefaults(&cfg, defaults)
+	maybePanic(err)
+
+	if !cfg.Host.Equal(StringFrom("example.com")) {
+		t.Errorf("valid Host was overwritten: %#v", cfg.Host)
+	}
+	if !cfg.Port.Equal(IntFrom(8080)) {
+		t.Errorf("null Port was not filled: %#v", cfg.Port)
+	}
+	if !cfg.Ratio.Equal(FloatFrom(0.5)) {
+		t.Errorf("absent Ratio was not filled: %#v", cfg.Ratio)
+	}
+	if !cfg.Debug.Equal(BoolFrom(false)) {
+		t.Errorf("valid false Debug was overwritten: %#v", cfg.Debug)
+	}
+	if !cfg.Version.Equal(defaults.Version) {
+		t.Errorf("null Version was not filled: %#v", cfg.Version)
+	}
+	if cfg.Plain != "" || cfg.secret.Valid {
+		t.Error("fields that aren't exported Nullables should be left alone")
+	}
+
+	var fromPtr defaultsConfig
+	err = ApplyDefaults(&fromPtr, &defaults)
+	maybePanic(err)
+	if !fromPtr.Host.Equal(defaults.Host) {
+		t.Errorf("defaults given by pointer were not applied: %#v", fromPtr.Host)
+	}
+}
+
+func TestApplyDefaultsPointerFields(t *testing.T) {
+	type record struct {
+		Name  *String
+		Count *Int
+		Note  *String
+		Label *String
+	}
+	name, count := StringFrom("default"), IntFrom(42)
+	defaults := record{Name: &name, Count: &count, Note: &name}
+
+	set, null := StringFrom("set"), NewInt(0, false)
+	target := record{Note: &set, Count: &null}
+	err := ApplyDefaults(&target, defaults)
+	maybePanic(err)
+
+	if target.Name == nil || !target.Name.Equal(name) {
+		t.Errorf("nil Name was not filled: %#v", target.Name)
+	}
+	if target.Name == defaults.Name {
+		t.Error("filled Name should not share the default's pointer")
+	}
+	if target.Count != &null || !null.Equal(count) {
+		t.Errorf("null Count was not filled in place: %#v", target.Count)
+	}
+	if !target.Note.Equal(set) {
+		t.Errorf("valid Note was overwritten: %#v", target.Note)
+	}
+	if target.Label != nil {
+		t.Errorf("Label without a default should stay nil: %#v", target.Label)
+	}
+}
+
+func TestApplyDefaultsInvalidInput(t *testing.T) {
+	var cfg defaultsConfig
+	if err := ApplyDefaults(cfg, defaultsConfig{}); err == nil {
+		t.Error("expected error for non-pointer target")
+	}
+	if err := ApplyDefaults(&cfg, diffRecord{}); err == nil {
+		t.Error("expected error for defaults of another type")
+	}
+	var nilCfg *defaultsConfig
+	if err := ApplyDefaults(nilCfg, defaultsConfig{}); err == nil {
+		t.Error("expected error for nil target")
+	}
+}
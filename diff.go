@@ -0,0 +1,115 @@
+package null
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// errDiffNotStructs is returned by DiffNull when its arguments can't be compared.
+var errDiffNotStructs = errors.New("null: DiffNull needs two structs of the same type")
+
+// DiffNull compares two structs of the same type and returns the JSON names of the
+// fields whose values are not Equal. Pointers to structs are accepted as well.
+// Only exported fields with an Equal method taking their own type (such as every type
+// in this package) are compared, other fields are ignored. Fields tagged with `json:"-"`
+// are skipped, fields without a JSON name are reported by their Go name.
+func DiffNull(prev, next interface{}) ([]string, error) {
+	a, b := indirectStruct(prev), indirectStruct(next)
+	if !a.IsValid() || !b.IsValid() || a.Type() != b.Type() {
+		return nil, errDiffNotStructs
+	}
+
+	var changed []string
+	typ := a.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+		equal, ok := callEqual(a.Field(i), b.Field(i))
+		if ok && !equal {
+			changed = append(changed, name)
+		}
+	}
+	return changed, nil
+}
+
+// Changed returns the JSON names of the fields of the struct v that were present in the JSON
+// it was decoded from, whether their value was null or not. v may also be a pointer to a struct.
+// It is meant for PATCH handlers that only update the fields a request provided.
+// Only exported fields with a WasProvided method, such as Provided, are considered.
+// Pointer fields are only reported if they aren't nil, as encoding/json sets them to nil for null without calling UnmarshalJSON.
+// Like DiffNull, it skips fields tagged with `json:"-"` and reports fields without a JSON name by their Go name.
+// It returns nil if v is not a struct.
+func Changed(v interface{}) []string {
+	rv := indirectStruct(v)
+	if !rv.IsValid() {
+		return nil
+	}
+
+	var changed []string
+	typ := rv.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Ptr && fv.IsNil() {
+			continue
+		}
+		if p, ok := fv.Interface().(interface{ WasProvided() bool }); ok && p.WasProvided() {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+// indirectStruct returns the struct v holds or points to,
+// or the zero Value if v is neither.
+func indirectStruct(v interface{}) reflect.Value {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return rv
+}
+
+// jsonFieldName returns the name field is encoded with by encoding/json.
+// It returns false if the field is skipped by encoding/json.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if name := strings.Split(tag, ",")[0]; name != "" {
+		return name, true
+	}
+	return field.Name, true
+}
+
+// callEqual calls a.Equal(b). It returns false as a second value
+// if a has no Equal method taking its own type and returning bool.
+func callEqual(a, b reflect.Value) (equal, ok bool) {
+	method := a.MethodByName("Equal")
+	if !method.IsValid() {
+		return false, false
+	}
+	mt := method.Type()
+	if mt.NumIn() != 1 || mt.In(0) != a.Type() || mt.NumOut() != 1 || mt.Out(0).Kind() != reflect.Bool {
+		return false, false
+	}
+	return method.Call([]reflect.Value{b})[0].Bool(), true
+}
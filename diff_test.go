@@ -0,0 +1,100 @@
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type diffRecord struct {
+	ID      Int    `json:"id"`
+	Name    String `json:"name,omitempty"`
+	Email   String `json:"email"`
+	Score   Float
+	Ignored String `json:"-"`
+	Plain   int    `json:"plain"`
+	hidden  String
+}
+
+func TestDiffNull(t *testing.T) {
+	prev := diffRecord{
+		ID:      IntFrom(1),
+		Name:    StringFrom("alice"),
+		Email:   StringFrom("alice@example.com"),
+		Score:   FloatFrom(1.5),
+		Ignored: StringFrom("a"),
+		Plain:   1,
+		hidden:  StringFrom("a"),
+	}
+	next := prev
+	next.Name = StringFrom("bob")
+	next.Email = NewString("", false)
+	next.Ignored = StringFrom("b")
+	next.Plain = 2
+	next.hidden = StringFrom("b")
+
+	changed, err := DiffNull(prev, &next)
+	maybePanic(err)
+	if want := []string{"name", "email"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("DiffNull() = %v, want %v", changed, want)
+	}
+
+	next.Score = NewFloat(0, false)
+	changed, err = DiffNull(&prev, next)
+	maybePanic(err)
+	if want := []string{"name", "email", "Score"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("DiffNull() = %v, want %v", changed, want)
+	}
+
+	changed, err = DiffNull(prev, prev)
+	maybePanic(err)
+	if len(changed) != 0 {
+		t.Errorf("DiffNull() of equal structs = %v, want none", changed)
+	}
+}
+
+func TestDiffNullInvalidInput(t *testing.T) {
+	if _, err := DiffNull(diffRecord{}, struct{}{}); err == nil {
+		t.Error("expected error for different types")
+	}
+	if _, err := DiffNull(StringFrom("a"), 1); err == nil {
+		t.Error("expected error for non-struct input")
+	}
+	var nilRecord *diffRecord
+	if _, err := DiffNull(nilRecord, diffRecord{}); err == nil {
+		t.Error("expected error for nil pointer")
+	}
+}
+
+func TestChanged(t *testing.T) {
+	type patch struct {
+		Name    Provided[String] `json:"name"`
+		Email   Provided[String] `json:"email"`
+		Age     Provided[Int]    `json:"age"`
+		Score   *Provided[Float] `json:"score"`
+		Ignored Provided[String] `json:"-"`
+		Plain   string           `json:"plain"`
+		Label   Provided[CIString]
+	}
+
+	var p patch
+	err := json.Unmarshal([]byte(`{"name":"alice","email":null,"plain":"x","score":null,"Label":"A"}`), &p)
+	maybePanic(err)
+	if want := []string{"name", "email", "Label"}; !reflect.DeepEqual(Changed(&p), want) {
+		t.Errorf("Changed() = %v, want %v", Changed(&p), want)
+	}
+
+	p = patch{}
+	err = json.Unmarshal([]byte(`{"age":5,"score":1.5}`), &p)
+	maybePanic(err)
+	if want := []string{"age", "score"}; !reflect.DeepEqual(Changed(p), want) {
+		t.Errorf("Changed() = %v, want %v", Changed(p), want)
+	}
+
+	if got := Changed(patch{Name: Provided[String]{Val: StringFrom("set in code")}}); got != nil {
+		t.Errorf("Changed() of a struct not decoded from JSON = %v, want nil", got)
+	}
+	if got := Changed(42); got != nil {
+		t.Errorf("Changed(42) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,110 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// fakeDriver is a minimal database/sql driver for tests.
+// Every Exec stores its arguments as a new row of the store named by the DSN,
+// every Query returns the stored rows, with columns named c0, c1, and so on.
+type fakeDriver struct{}
+
+var (
+	fakeDriverOnce sync.Once
+	fakeStoresMu   sync.Mutex
+	fakeStores     = map[string][][]driver.Value{}
+)
+
+// openFakeDB returns a database backed by an empty store named name.
+func openFakeDB(name string) *sql.DB {
+	fakeDriverOnce.Do(func() {
+		sql.Register("nulltest", fakeDriver{})
+	})
+	fakeStoresMu.Lock()
+	fakeStores[name] = nil
+	fakeStoresMu.Unlock()
+	db, err := sql.Open("nulltest", name)
+	maybePanic(err)
+	return db
+}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	return fakeConn{store: name}, nil
+}
+
+type fakeConn struct {
+	store string
+}
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt(c), nil
+}
+
+func (fakeConn) Close() error {
+	return nil
+}
+
+func (fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fake driver: transactions are not supported")
+}
+
+type fakeStmt struct {
+	store string
+}
+
+func (fakeStmt) Close() error {
+	return nil
+}
+
+func (fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	fakeStoresMu.Lock()
+	defer fakeStoresMu.Unlock()
+	fakeStores[s.store] = append(fakeStores[s.store], args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	fakeStoresMu.Lock()
+	defer fakeStoresMu.Unlock()
+	rows := fakeStores[s.store]
+	width := 0
+	if len(rows) > 0 {
+		width = len(rows[0])
+	}
+	return &fakeRows{rows: rows, width: width}, nil
+}
+
+type fakeRows struct {
+	rows  [][]driver.Value
+	width int
+}
+
+func (r *fakeRows) Columns() []string {
+	cols := make([]string, r.width)
+	for i := range cols {
+		cols[i] = "c" + strconv.Itoa(i)
+	}
+	return cols
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
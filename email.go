@@ -0,0 +1,217 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+)
+
+// Email is a nullable email address, validated with net/mail.ParseAddress.
+// Only bare addresses such as gopher@example.com are accepted, not ones with a display name.
+// It will marshal to null if null.
+type Email struct {
+	Address string
+	Valid   bool
+}
+
+// EmailFrom validates s and returns a valid Email.
+// It returns an error and a null Email if s is not a bare email address.
+func EmailFrom(s string) (Email, error) {
+	e, err := parseEmail(s)
+	if err != nil {
+		return Email{}, newUnmarshalError("Email", err)
+	}
+	return e, nil
+}
+
+// MustEmail is like EmailFrom but panics if s is not a bare email address.
+// It simplifies initializing fixtures and package-level variables, like regexp.MustCompile.
+func MustEmail(s string) Email {
+	v, err := EmailFrom(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns the address, or a blank string if this Email is null.
+func (e Email) String() string {
+	if !e.Valid {
+		return ""
+	}
+	return e.Address
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil input.
+func (e *Email) Scan(value interface{}) error {
+	return reportError(e.scan(value))
+}
+
+func (e *Email) scan(value interface{}) error {
+	var err error
+	switch x := scanSource(value).(type) {
+	case nil:
+		*e = Email{}
+		return nil
+	case string:
+		*e, err = parseEmail(x)
+	case []byte:
+		*e, err = parseEmail(string(x))
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		*e = Email{}
+		return newScanError("Email", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It stores the address.
+func (e Email) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return e.Address, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (e *Email) UnmarshalJSON(data []byte) error {
+	return reportError(e.unmarshalJSON(data))
+}
+
+func (e *Email) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*e = Email{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		*e = Email{}
+		return newUnmarshalError("Email", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	parsed, err := parseEmail(str)
+	if err != nil {
+		*e = Email{}
+		return newUnmarshalError("Email", err)
+	}
+	*e = parsed
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Email if the input is blank.
+func (e *Email) UnmarshalText(text []byte) error {
+	return reportError(e.unmarshalText(text))
+}
+
+func (e *Email) unmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*e = Email{}
+		return nil
+	}
+	parsed, err := parseEmail(string(text))
+	if err != nil {
+		*e = Email{}
+		return newUnmarshalError("Email", err)
+	}
+	*e = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Email is null.
+func (e Email) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(e.Address)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Email is null.
+func (e Email) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// Reset makes this Email null and clears its value, so a reused Email doesn't keep previous data.
+func (e *Email) Reset() {
+	*e = Email{}
+}
+
+// IsZero returns true for null Emails.
+func (e Email) IsZero() bool {
+	return !e.Valid
+}
+
+// AsAny returns nil if this Email is null, otherwise the address.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (e Email) AsAny() interface{} {
+	if !e.Valid {
+		return nil
+	}
+	return e.Address
+}
+
+// Equal returns true if both addresses are the same or are both null.
+// The domain is compared case-insensitively, the local part before the @ is not.
+func (e Email) Equal(other Email) bool {
+	if e.Valid != other.Valid {
+		return false
+	}
+	if !e.Valid {
+		return true
+	}
+	local, domain, ok := splitEmail(e.Address)
+	otherLocal, otherDomain, otherOK := splitEmail(other.Address)
+	if !ok || !otherOK {
+		// only possible if Address was set to something invalid directly
+		return e.Address == other.Address
+	}
+	return local == otherLocal && strings.EqualFold(domain, otherDomain)
+}
+
+// JSONSchema returns the JSON Schema of this Email's JSON encoding: an email address string or null.
+func (e Email) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("string")
+	schema["format"] = "email"
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Email as the Go code that creates it.
+func (e Email) GoString() string {
+	if !e.Valid {
+		return "null.Email{}"
+	}
+	return "null.Email{Address: " + strconv.Quote(e.Address) + ", Valid: true}"
+}
+
+// parseEmail validates a bare email address.
+func parseEmail(s string) (Email, error) {
+	addr, err := mail.ParseAddress(s)
+	if err != nil {
+		return Email{}, fmt.Errorf("invalid email %q: %w", s, err)
+	}
+	if addr.Name != "" || addr.Address != s {
+		return Email{}, fmt.Errorf("invalid email %q: only bare addresses are accepted", s)
+	}
+	return Email{Address: s, Valid: true}, nil
+}
+
+// splitEmail splits an address at its last @. It returns false if there is no @.
+func splitEmail(address string) (local, domain string, ok bool) {
+	i := strings.LastIndexByte(address, '@')
+	if i < 0 {
+		return "", "", false
+	}
+	return address[:i], address[i+1:], true
+}
@@ -0,0 +1,111 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+)
+
+func TestEmailValid(t *testing.T) {
+	for _, in := range []string{"gopher@example.com", "first.last+tag@sub.example.org"} {
+		var e Email
+		err := json.Unmarshal([]byte(`"`+in+`"`), &e)
+		maybePanic(err)
+		if !e.Valid || e.Address != in {
+			t.Errorf("bad unmarshal of %s: %#v", in, e)
+		}
+
+		data, err := json.Marshal(e)
+		maybePanic(err)
+		assertJSONEquals(t, data, `"`+in+`"`, "email json marshal")
+
+		v, err := e.Value()
+		maybePanic(err)
+		if v != in {
+			t.Errorf("bad value: %#v", v)
+		}
+
+		str := in
+		for _, src := range []interface{}{[]byte(in), sql.RawBytes(in), &str, sql.NullString{String: in, Valid: true}} {
+			var scanned Email
+			err = scanned.Scan(src)
+			maybePanic(err)
+			if !scanned.Equal(e) {
+				t.Errorf("bad scan of %#v: %#v", src, scanned)
+			}
+		}
+	}
+}
+
+func TestEmailInvalid(t *testing.T) {
+	for _, in := range []string{"gopher", "gopher@", "@example.com", "Gopher <gopher@example.com>", " gopher@example.com", "a@b@c"} {
+		e := Email{Address: "x@example.com", Valid: true}
+		if err := e.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+		if e.Valid {
+			t.Errorf("%q should be invalid", in)
+		}
+		if _, err := EmailFrom(in); err == nil {
+			t.Errorf("expected error from EmailFrom(%q)", in)
+		}
+		if err := e.Scan(in); err == nil {
+			t.Errorf("expected error scanning %q", in)
+		}
+	}
+	var e Email
+	if err := json.Unmarshal([]byte(`12345`), &e); err == nil {
+		t.Error("expected error for number json")
+	}
+}
+
+func TestEmailNull(t *testing.T) {
+	var e Email
+	err := json.Unmarshal(nullJSON, &e)
+	maybePanic(err)
+	if e.Valid {
+		t.Error("null json should be invalid")
+	}
+	data, err := json.Marshal(e)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null email json marshal")
+
+	err = e.Scan(nil)
+	maybePanic(err)
+	v, err := e.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null value should be nil, not %#v", v)
+	}
+}
+
+func TestEmailEqual(t *testing.T) {
+	a, err := EmailFrom("Gopher@Example.COM")
+	maybePanic(err)
+	b, err := EmailFrom("Gopher@example.com")
+	maybePanic(err)
+	c, err := EmailFrom("gopher@example.com")
+	maybePanic(err)
+	if !a.Equal(b) {
+		t.Error("domains should compare case-insensitively")
+	}
+	if a.Equal(c) {
+		t.Error("local parts should compare case-sensitively")
+	}
+	if a.Equal(Email{}) || !(Email{}).Equal(Email{}) {
+		t.Error("bad null comparison")
+	}
+
+	// addresses without an @ can only be set directly, and compare as plain strings
+	noAt := Email{Address: "Gopher", Valid: true}
+	if !noAt.Equal(noAt) || noAt.Equal(Email{Address: "gopher", Valid: true}) || noAt.Equal(Email{Address: "Gopher@", Valid: true}) {
+		t.Error("bad comparison of addresses without an @")
+	}
+}
+
+func TestMustEmail(t *testing.T) {
+	if e := MustEmail("gopher@example.com"); !e.Valid || e.Address != "gopher@example.com" {
+		t.Errorf("bad MustEmail(): %#v", e)
+	}
+	assertPanics(t, func() { MustEmail("gopher") }, "MustEmail(gopher)")
+}
@@ -0,0 +1,118 @@
+package null
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// EncryptFunc encrypts or decrypts the value of an EncryptedString.
+type EncryptFunc func([]byte) ([]byte, error)
+
+// errNoCipher is returned when an EncryptedString wasn't created with NewEncryptedString.
+var errNoCipher = errors.New("no cipher set, use NewEncryptedString")
+
+// EncryptedString is a nullable string that is encrypted at rest.
+// Value encrypts it before it is stored, and Scan decrypts what the database returns,
+// so queries deal only in plaintext. Null values are passed through untouched.
+// JSON and text hold the plaintext, like String.
+type EncryptedString struct {
+	String
+
+	encrypt, decrypt EncryptFunc
+}
+
+// NewEncryptedString creates a new null EncryptedString that encrypts with enc and decrypts with dec.
+// Use SetValid to give it a value, or pass it to Scan.
+func NewEncryptedString(enc, dec EncryptFunc) EncryptedString {
+	return EncryptedString{encrypt: enc, decrypt: dec}
+}
+
+// Scan implements the sql.Scanner interface.
+// It decrypts string and []byte input, and returns an error if decryption fails.
+func (s *EncryptedString) Scan(value interface{}) error {
+	return reportError(s.scan(value))
+}
+
+func (s *EncryptedString) scan(value interface{}) error {
+	var err error
+	switch v := scanSource(value).(type) {
+	case nil:
+		s.String = String{}
+		return nil
+	case string:
+		err = s.setCiphertext([]byte(v))
+	case []byte:
+		err = s.setCiphertext(v)
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		s.String = String{}
+		return newScanError("EncryptedString", value, err)
+	}
+	return nil
+}
+
+// ScanContext is like Scan, but returns ctx.Err() without scanning if ctx is already done.
+// It overrides String.ScanContext, which would skip decryption.
+func (s *EncryptedString) ScanContext(ctx context.Context, value interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.Scan(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports the same input as String, which holds the plaintext.
+func (s *EncryptedString) UnmarshalJSON(data []byte) error {
+	return reportError(s.unmarshalJSON(data))
+}
+
+func (s *EncryptedString) unmarshalJSON(data []byte) error {
+	return retypeError("EncryptedString", s.String.unmarshalJSON(data))
+}
+
+// setCiphertext decrypts ciphertext into s.
+func (s *EncryptedString) setCiphertext(ciphertext []byte) error {
+	if s.decrypt == nil {
+		return errNoCipher
+	}
+	plaintext, err := s.decrypt(ciphertext)
+	if err != nil {
+		return fmt.Errorf("couldn't decrypt: %w", err)
+	}
+	s.String = StringFrom(string(plaintext))
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It stores the encrypted value as []byte, or NULL if this EncryptedString is null.
+func (s EncryptedString) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	if s.encrypt == nil {
+		return nil, fmt.Errorf("null: EncryptedString: %w", errNoCipher)
+	}
+	ciphertext, err := s.encrypt([]byte(s.String.String))
+	if err != nil {
+		return nil, fmt.Errorf("null: EncryptedString: couldn't encrypt: %w", err)
+	}
+	return ciphertext, nil
+}
+
+// Equal returns true if both EncryptedStrings have the same plaintext or are both null.
+func (s EncryptedString) Equal(other EncryptedString) bool {
+	return s.String.Equal(other.String)
+}
+
+// GoString implements fmt.GoStringer. The cipher functions can't be printed, so only the plaintext is shown.
+func (s EncryptedString) GoString() string {
+	if !s.Valid {
+		return "null.EncryptedString{}"
+	}
+	return "null.EncryptedString{String: null.StringFrom(" + strconv.Quote(s.String.String) + ")}"
+}
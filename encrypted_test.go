@@ -0,0 +1,116 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var errBadCiphertext = errors.New("bad ciphertext")
+
+// xorCipher is a trivial, reversible cipher for tests. Decrypting rejects input without the 0x00 prefix.
+func xorCipher() (enc, dec EncryptFunc) {
+	enc = func(b []byte) ([]byte, error) {
+		out := []byte{0}
+		for _, c := range b {
+			out = append(out, c^0x5a)
+		}
+		return out, nil
+	}
+	dec = func(b []byte) ([]byte, error) {
+		if len(b) == 0 || b[0] != 0 {
+			return nil, errBadCiphertext
+		}
+		out := make([]byte, 0, len(b)-1)
+		for _, c := range b[1:] {
+			out = append(out, c^0x5a)
+		}
+		return out, nil
+	}
+	return enc, dec
+}
+
+func TestEncryptedString(t *testing.T) {
+	enc, dec := xorCipher()
+	s := NewEncryptedString(enc, dec)
+	s.SetValid("test")
+
+	v, err := s.Value()
+	maybePanic(err)
+	ciphertext, ok := v.([]byte)
+	if !ok || bytes.Contains(ciphertext, []byte("test")) {
+		t.Errorf("value should be encrypted: %#v", v)
+	}
+
+	db := openFakeDB(t.Name())
+	defer db.Close()
+	_, err = db.Exec("INSERT", s)
+	maybePanic(err)
+	_, err = db.Exec("INSERT", NewEncryptedString(enc, dec))
+	maybePanic(err)
+	rows, err := db.Query("SELECT")
+	maybePanic(err)
+	defer rows.Close()
+
+	scanned := NewEncryptedString(enc, dec)
+	rows.Next()
+	err = rows.Scan(&scanned)
+	maybePanic(err)
+	assertStr(t, scanned.String, "decrypted string")
+	if !scanned.Equal(s) {
+		t.Errorf("round trip changed the value: %#v ≠ %#v", scanned, s)
+	}
+
+	rows.Next()
+	err = rows.Scan(&scanned)
+	maybePanic(err)
+	assertNullStr(t, scanned.String, "scanned null")
+	if v, err := scanned.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	data, err := json.Marshal(s)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"test"`, "json holds the plaintext")
+}
+
+func TestEncryptedStringScanSources(t *testing.T) {
+	// plain decrypts by dropping the prefix, so the plaintext shares memory with the ciphertext
+	plain := func(b []byte) ([]byte, error) { return b[1:], nil }
+	buf := sql.RawBytes("\x00test")
+	s := NewEncryptedString(nil, plain)
+	err := s.Scan(buf)
+	maybePanic(err)
+	copy(buf, "\x00xxxx")
+	assertStr(t, s.String, "scanned RawBytes")
+
+	ciphertext := []byte("\x00test")
+	s = NewEncryptedString(nil, plain)
+	err = s.Scan(&ciphertext)
+	maybePanic(err)
+	assertStr(t, s.String, "scanned *[]byte")
+}
+
+func TestEncryptedStringErrors(t *testing.T) {
+	enc, dec := xorCipher()
+	s := NewEncryptedString(enc, dec)
+	s.SetValid("test")
+	if err := s.Scan([]byte("plaintext")); !errors.Is(err, errBadCiphertext) {
+		t.Errorf("expected decrypt error, not %v", err)
+	}
+	assertNullStr(t, s.String, "failed decrypt")
+
+	var noCipher EncryptedString
+	if err := noCipher.Scan("x"); !errors.Is(err, errNoCipher) {
+		t.Errorf("expected errNoCipher scanning, not %v", err)
+	}
+	noCipher.SetValid("x")
+	if _, err := noCipher.Value(); !errors.Is(err, errNoCipher) {
+		t.Errorf("expected errNoCipher from Value(), not %v", err)
+	}
+	if err := noCipher.Scan(nil); err != nil {
+		t.Errorf("null should scan without a cipher, got %v", err)
+	}
+}
@@ -0,0 +1,238 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// EnumIntMarshalCode makes EnumInt marshal to JSON as its integer code instead of its name.
+// UnmarshalJSON accepts both forms regardless.
+//
+// Deprecated: set Config.EnumIntMarshalCode with SetConfig, which is safe to call while values are decoded.
+// This variable is ignored once SetConfig has been called.
+var EnumIntMarshalCode = false
+
+// EnumCode is implemented by integer types used as enum codes with EnumInt.
+// Names returns the name of every valid code; codes missing from it are rejected.
+// Names is called on the zero value, so it should return a shared map.
+type EnumCode[T comparable] interface {
+	~int
+	Names() map[T]string
+}
+
+// EnumInt is a nullable enum stored in SQL as its integer code
+// and marshaled to JSON as its name, or null if null.
+type EnumInt[T EnumCode[T]] struct {
+	Enum  T
+	Valid bool
+}
+
+// NewEnumInt creates a new EnumInt
+func NewEnumInt[T EnumCode[T]](e T, valid bool) EnumInt[T] {
+	return EnumInt[T]{Enum: e, Valid: valid}
+}
+
+// EnumIntFrom creates a new EnumInt that will always be valid.
+func EnumIntFrom[T EnumCode[T]](e T) EnumInt[T] {
+	return NewEnumInt(e, true)
+}
+
+// Name returns the name of this EnumInt's code, or a blank string if it is null or unknown.
+func (e EnumInt[T]) Name() string {
+	if !e.Valid {
+		return ""
+	}
+	return e.Enum.Names()[e.Enum]
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports integer codes and nil input, and returns an error for unknown codes.
+func (e *EnumInt[T]) Scan(value interface{}) error {
+	return reportError(e.scan(value))
+}
+
+func (e *EnumInt[T]) scan(value interface{}) error {
+	i, err := scanInt(value)
+	if err != nil {
+		*e = EnumInt[T]{}
+		return newScanError("EnumInt", value, err)
+	}
+	if !i.Valid {
+		*e = EnumInt[T]{}
+		return nil
+	}
+	return e.setCode(i.Int64)
+}
+
+// Value implements the driver Valuer interface.
+// It stores the integer code.
+func (e EnumInt[T]) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return int64(e.Enum), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports name string, integer code, and null input.
+func (e *EnumInt[T]) UnmarshalJSON(data []byte) error {
+	return reportError(e.unmarshalJSON(data))
+}
+
+func (e *EnumInt[T]) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*e = EnumInt[T]{}
+		return nil
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		*e = EnumInt[T]{}
+		return newUnmarshalError("EnumInt", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	switch x := v.(type) {
+	case string:
+		return e.setName(x)
+	case json.Number:
+		code, err := x.Int64()
+		if err != nil {
+			*e = EnumInt[T]{}
+			return newUnmarshalError("EnumInt", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+		}
+		return e.setCode(code)
+	}
+	*e = EnumInt[T]{}
+	return newUnmarshalError("EnumInt", fmt.Errorf("JSON input is invalid type (need string or int): %s", data))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports a name or an integer code, and will unmarshal to a null EnumInt if the input is blank or "null".
+func (e *EnumInt[T]) UnmarshalText(text []byte) error {
+	return reportError(e.unmarshalText(text))
+}
+
+func (e *EnumInt[T]) unmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		*e = EnumInt[T]{}
+		return nil
+	}
+	if code, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return e.setCode(code)
+	}
+	return e.setName(str)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this EnumInt is null,
+// and its code instead of its name if EnumIntMarshalCode is set.
+func (e EnumInt[T]) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return []byte("null"), nil
+	}
+	if enumIntMarshalCode() {
+		return []byte(strconv.Itoa(int(e.Enum))), nil
+	}
+	name, ok := e.Enum.Names()[e.Enum]
+	if !ok {
+		return nil, fmt.Errorf("null: EnumInt: couldn't marshal unknown code %d", int(e.Enum))
+	}
+	return json.Marshal(name)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this EnumInt is null.
+func (e EnumInt[T]) MarshalText() ([]byte, error) {
+	return []byte(e.Name()), nil
+}
+
+// SetValid changes this EnumInt's value and also sets it to be non-null.
+func (e *EnumInt[T]) SetValid(v T) {
+	e.Enum = v
+	e.Valid = true
+}
+
+// Reset makes this EnumInt null and clears its value, so a reused EnumInt doesn't keep previous data.
+func (e *EnumInt[T]) Reset() {
+	*e = EnumInt[T]{}
+}
+
+// IsZero returns true for null EnumInts.
+func (e EnumInt[T]) IsZero() bool {
+	return !e.Valid
+}
+
+// AsAny returns nil if this EnumInt is null, otherwise its value as T.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (e EnumInt[T]) AsAny() interface{} {
+	if !e.Valid {
+		return nil
+	}
+	return e.Enum
+}
+
+// Equal returns true if both enums have the same code or are both null.
+func (e EnumInt[T]) Equal(other EnumInt[T]) bool {
+	return e.Valid == other.Valid && (!e.Valid || e.Enum == other.Enum)
+}
+
+// setCode stores code if it is known, otherwise it makes e null and returns an error.
+func (e *EnumInt[T]) setCode(code int64) error {
+	v := T(code)
+	if _, ok := v.Names()[v]; !ok || int64(v) != code {
+		*e = EnumInt[T]{}
+		return newUnmarshalError("EnumInt", fmt.Errorf("unknown code %d", code))
+	}
+	e.SetValid(v)
+	return nil
+}
+
+// setName stores the code named name if there is one, otherwise it makes e null and returns an error.
+func (e *EnumInt[T]) setName(name string) error {
+	var zero T
+	for code, n := range zero.Names() {
+		if n == name {
+			e.SetValid(code)
+			return nil
+		}
+	}
+	*e = EnumInt[T]{}
+	return newUnmarshalError("EnumInt", fmt.Errorf("unknown name %q", name))
+}
+
+// JSONSchema returns the JSON Schema of this EnumInt's JSON encoding: one of the enum names, or codes if EnumIntMarshalCode is set, or null.
+func (e EnumInt[T]) JSONSchema() map[string]interface{} {
+	names := e.Enum.Names()
+	codes := make([]T, 0, len(names))
+	for code := range names {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+	asCode := enumIntMarshalCode()
+	values := make([]interface{}, len(codes))
+	for i, code := range codes {
+		values[i] = names[code]
+		if asCode {
+			values[i] = int(code)
+		}
+	}
+	if asCode {
+		return nullableEnumSchema("integer", values)
+	}
+	return nullableEnumSchema("string", values)
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this EnumInt as the Go code that creates it.
+func (e EnumInt[T]) GoString() string {
+	if !e.Valid {
+		return fmt.Sprintf("null.NewEnumInt[%T](0, false)", e.Enum)
+	}
+	return fmt.Sprintf("null.EnumIntFrom[%T](%d)", e.Enum, int(e.Enum))
+}
@@ -0,0 +1,144 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type orderStatus int
+
+const (
+	orderPending orderStatus = iota + 1
+	orderShipped
+	orderDelivered
+)
+
+var orderStatusNames = map[orderStatus]string{
+	orderPending:   "pending",
+	orderShipped:   "shipped",
+	orderDelivered: "delivered",
+}
+
+func (orderStatus) Names() map[orderStatus]string {
+	return orderStatusNames
+}
+
+func TestEnumIntScan(t *testing.T) {
+	for code, name := range orderStatusNames {
+		var e EnumInt[orderStatus]
+		err := e.Scan(int64(code))
+		maybePanic(err)
+		if !e.Valid || e.Enum != code || e.Name() != name {
+			t.Errorf("bad scan of %d: %#v", code, e)
+		}
+
+		v, err := e.Value()
+		maybePanic(err)
+		if v != int64(code) {
+			t.Errorf("bad value of %d: %#v", code, v)
+		}
+	}
+
+	unknown := EnumIntFrom(orderShipped)
+	if err := unknown.Scan(int64(4)); err == nil {
+		t.Error("expected error for unknown code")
+	}
+	if unknown.Valid {
+		t.Error("unknown code should be invalid")
+	}
+
+	var null EnumInt[orderStatus]
+	err := null.Scan(nil)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("scanned nil should be invalid")
+	}
+	v, err := null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null value should be nil, not %#v", v)
+	}
+}
+
+func TestEnumIntMarshalJSON(t *testing.T) {
+	defer func(prev bool) { EnumIntMarshalCode = prev }(EnumIntMarshalCode)
+
+	e := EnumIntFrom(orderDelivered)
+	data, err := json.Marshal(e)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"delivered"`, "enum json marshal")
+
+	EnumIntMarshalCode = true
+	data, err = json.Marshal(e)
+	maybePanic(err)
+	assertJSONEquals(t, data, `3`, "enum code json marshal")
+
+	data, err = json.Marshal(NewEnumInt(orderPending, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null enum json marshal")
+
+	EnumIntMarshalCode = false
+	_, err = EnumIntFrom(orderStatus(99)).MarshalJSON()
+	var ue *UnmarshalError
+	if err == nil || errors.As(err, &ue) {
+		t.Errorf("expected a marshal error for an unknown code, not %v", err)
+	}
+}
+
+func TestEnumIntUnmarshalJSON(t *testing.T) {
+	for _, in := range []string{`"shipped"`, `2`} {
+		var e EnumInt[orderStatus]
+		err := json.Unmarshal([]byte(in), &e)
+		maybePanic(err)
+		if !e.Equal(EnumIntFrom(orderShipped)) {
+			t.Errorf("bad unmarshal of %s: %#v", in, e)
+		}
+	}
+
+	var null EnumInt[orderStatus]
+	err := json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should be invalid")
+	}
+
+	for _, bad := range []string{`"lost"`, `4`, `1.5`, `true`, `{}`} {
+		e := EnumIntFrom(orderPending)
+		if err := json.Unmarshal([]byte(bad), &e); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+		if e.Valid {
+			t.Errorf("%s should be invalid", bad)
+		}
+	}
+}
+
+func TestEnumIntText(t *testing.T) {
+	var e EnumInt[orderStatus]
+	err := e.UnmarshalText([]byte("pending"))
+	maybePanic(err)
+	if !e.Equal(EnumIntFrom(orderPending)) {
+		t.Errorf("bad unmarshal of name: %#v", e)
+	}
+
+	err = e.UnmarshalText([]byte("3"))
+	maybePanic(err)
+	if !e.Equal(EnumIntFrom(orderDelivered)) {
+		t.Errorf("bad unmarshal of code: %#v", e)
+	}
+
+	data, err := e.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "delivered", "enum text marshal")
+
+	err = e.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if e.Valid {
+		t.Error("blank text should be invalid")
+	}
+
+	if err := e.UnmarshalText([]byte("lost")); err == nil {
+		t.Error("expected error for unknown name")
+	}
+}
@@ -0,0 +1,97 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// UnmarshalError is returned when input can't be unmarshaled or scanned into one of the types in this package.
+// Use errors.As to find out which type failed.
+type UnmarshalError struct {
+	// Type is the name of the type that failed, for example "Int".
+	Type string
+	// Err is the underlying cause.
+	Err error
+}
+
+// OnUnmarshalError, if set, is called with the type name and cause whenever UnmarshalJSON, UnmarshalText
+// or Scan of a type in this package fails, for example to count bad data in metrics.
+// A failure is reported once, under the name of the type the input was decoded into: when one type
+// decodes through another, such as ValidatedString through String, only the outer type is reported.
+// Constructors such as EmailFrom and encoding methods don't report their errors.
+// It is nil by default. Set it before decoding starts, as it is read without synchronization.
+var OnUnmarshalError func(typeName string, err error)
+
+// reportError passes err to OnUnmarshalError if it is set and err holds an UnmarshalError, and returns err.
+// The exported UnmarshalJSON, UnmarshalText and Scan methods call it with the error of their unexported
+// counterparts, which types decoding through other types call instead, so each failure is reported once.
+func reportError(err error) error {
+	if err == nil || OnUnmarshalError == nil {
+		return err
+	}
+	var unmarshalErr *UnmarshalError
+	if errors.As(err, &unmarshalErr) {
+		OnUnmarshalError(unmarshalErr.Type, unmarshalErr.Err)
+	}
+	return err
+}
+
+// newUnmarshalError wraps err in an UnmarshalError for the named type.
+func newUnmarshalError(typ string, err error) error {
+	return &UnmarshalError{Type: typ, Err: err}
+}
+
+// newScanError wraps an error from scanning value into the named type,
+// naming both the source and the target type in the message.
+func newScanError(typ string, value interface{}, err error) error {
+	return newUnmarshalError(typ, fmt.Errorf("cannot scan %T into null.%s: %w", value, typ, err))
+}
+
+// retypeError wraps err in an UnmarshalError for the named type.
+// If err already is an UnmarshalError, for example from a type decoded as an intermediate step,
+// its cause is rewrapped instead.
+func retypeError(typ string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var unmarshalErr *UnmarshalError
+	if errors.As(err, &unmarshalErr) {
+		return &UnmarshalError{Type: typ, Err: unmarshalErr.Err}
+	}
+	return newUnmarshalError(typ, err)
+}
+
+// unmarshalUnreported decodes data into v like json.Unmarshal, but through v's unexported unmarshalJSON method
+// if it has one, so a type decoding into a value of this package reports failures only under its own name.
+func unmarshalUnreported(data []byte, v interface{}) error {
+	if u, ok := v.(interface{ unmarshalJSON([]byte) error }); ok {
+		return u.unmarshalJSON(data)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// scanUnreported is like unmarshalUnreported for sql.Scanner. ok is false if v has no Scan method.
+func scanUnreported(value, v interface{}) (ok bool, err error) {
+	if s, ok := v.(interface{ scan(interface{}) error }); ok {
+		return true, s.scan(value)
+	}
+	if s, ok := v.(sql.Scanner); ok {
+		return true, s.Scan(value)
+	}
+	return false, nil
+}
+
+// errUnsupportedScanType is wrapped by Scan errors for source types a type can't be scanned from.
+var errUnsupportedScanType = errors.New("unsupported type")
+
+// Error implements the error interface.
+func (e *UnmarshalError) Error() string {
+	return "null: " + e.Type + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying cause.
+func (e *UnmarshalError) Unwrap() error {
+	return e.Err
+}
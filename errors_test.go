@@ -0,0 +1,172 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalErrorType(t *testing.T) {
+	var (
+		i  Int
+		f  Float
+		b  Bool
+		s  String
+		ti Time
+		ts Timestamp
+	)
+	tests := []struct {
+		typ string
+		err error
+	}{
+		{"Int", i.UnmarshalJSON([]byte(`true`))},
+		{"Int", i.UnmarshalText([]byte("abc"))},
+		{"Int", i.Scan("abc")},
+		{"Float", f.UnmarshalJSON(invalidJSON)},
+		{"Float", f.UnmarshalText([]byte("abc"))},
+		{"Float", f.Scan("abc")},
+		{"Bool", b.UnmarshalJSON(intJSON)},
+		{"Bool", b.UnmarshalText([]byte("abc"))},
+		{"Bool", b.Scan("abc")},
+		{"String", s.UnmarshalJSON(boolJSON)},
+		{"Time", ti.UnmarshalJSON(intJSON)},
+		{"Time", ti.UnmarshalText([]byte("abc"))},
+		{"Time", ti.Scan(int64(42))},
+		{"Timestamp", ts.UnmarshalJSON(stringJSON)},
+		{"Timestamp", ts.UnmarshalText([]byte("abc"))},
+		{"Timestamp", ts.Scan(true)},
+	}
+	for _, test := range tests {
+		var unmarshalErr *UnmarshalError
+		if !errors.As(test.err, &unmarshalErr) {
+			t.Errorf("expected *UnmarshalError for %s, not %T", test.typ, test.err)
+			continue
+		}
+		if unmarshalErr.Type != test.typ {
+			t.Errorf("bad UnmarshalError type: %s ≠ %s", unmarshalErr.Type, test.typ)
+		}
+		if prefix := "null: " + test.typ + ": "; !strings.HasPrefix(test.err.Error(), prefix) {
+			t.Errorf("error %q should start with %q", test.err, prefix)
+		}
+	}
+}
+
+func TestUnmarshalErrorUnwrap(t *testing.T) {
+	var i Int
+	err := i.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+}
+
+func TestScanErrorInvalidates(t *testing.T) {
+	i := IntFrom(1)
+	if err := i.Scan("abc"); err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt(t, i, "failed scan")
+}
+
+func TestScanErrorNamesTypes(t *testing.T) {
+	tests := []struct {
+		dest   sql.Scanner
+		value  interface{}
+		source string
+		target string
+	}{
+		{new(String), struct{}{}, "struct {}", "null.String"},
+		{new(Int), "abc", "string", "null.Int"},
+		{new(Float), "abc", "string", "null.Float"},
+		{new(Bool), "abc", "string", "null.Bool"},
+		{new(Time), int64(42), "int64", "null.Time"},
+		{new(Timestamp), true, "bool", "null.Timestamp"},
+		{new(Number), true, "bool", "null.Number"},
+		{new(Percent), "abc", "string", "null.Percent"},
+		{new(Semver), int64(1), "int64", "null.Semver"},
+		{new(Color), int64(1), "int64", "null.Color"},
+	}
+	for _, test := range tests {
+		err := test.dest.Scan(test.value)
+		if err == nil {
+			t.Errorf("%s: expected error scanning %s", test.target, test.source)
+			continue
+		}
+		want := "cannot scan " + test.source + " into " + test.target
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should contain %q", err, want)
+		}
+	}
+}
+
+func TestOnUnmarshalError(t *testing.T) {
+	defer func(prev func(string, error)) { OnUnmarshalError = prev }(OnUnmarshalError)
+	var reported []string
+	OnUnmarshalError = func(typeName string, err error) {
+		if err == nil {
+			t.Errorf("%s: hook called with nil error", typeName)
+		}
+		reported = append(reported, typeName)
+	}
+
+	var (
+		i Int
+		d Decimal
+		s Ints
+		f FormBool
+		e EncryptedString
+		g Tagged[Int]
+		v = NewValidatedString(func(string) error { return errors.New("rejected") })
+	)
+	_, intsErr := UnmarshalInts([]byte(`[1,"x"]`))
+	tests := []struct {
+		typ string
+		err error
+	}{
+		{"Int", intsErr},
+		{"Int", i.UnmarshalJSON([]byte(`true`))},
+		{"Int", i.Scan("abc")},
+		{"Decimal", d.Scan(1.5)},
+		{"Ints", s.UnmarshalJSON([]byte(`[1,"x"]`))},
+		{"ValidatedString", v.UnmarshalJSON(stringJSON)},
+		{"ValidatedString", v.UnmarshalJSON(boolJSON)},
+		{"FormBool", f.UnmarshalJSON(stringJSON)},
+		{"FormBool", f.Scan("maybe")},
+		{"EncryptedString", e.UnmarshalJSON(boolJSON)},
+		{"Tagged", g.UnmarshalJSON([]byte(`{"value":"x","valid":true}`))},
+		{"Tagged", g.Scan("abc")},
+	}
+	for n, test := range tests {
+		if test.err == nil {
+			t.Errorf("%d: expected error", n)
+		}
+	}
+	if len(reported) != len(tests) {
+		t.Fatalf("hook called %d times, want %d: %v", len(reported), len(tests), reported)
+	}
+	for n, test := range tests {
+		if reported[n] != test.typ {
+			t.Errorf("%d: hook got type %s, want %s", n, reported[n], test.typ)
+		}
+	}
+
+	reported = nil
+	err := i.UnmarshalJSON(intJSON)
+	maybePanic(err)
+	if len(reported) != 0 {
+		t.Errorf("hook called without an error: %v", reported)
+	}
+
+	// Constructors and encoding aren't decoding, so their errors aren't reported.
+	if _, err := EmailFrom("not an email"); err == nil {
+		t.Error("EmailFrom: expected error")
+	}
+	if _, err := (Base64{Base64: "!!!", Valid: true}).Value(); err == nil {
+		t.Error("Base64.Value: expected error")
+	}
+	if len(reported) != 0 {
+		t.Errorf("hook called outside decoding: %v", reported)
+	}
+}
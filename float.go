@@ -41,6 +41,21 @@ func FloatFromPtr(f *float64) Float {
 	return NewFloat(*f, true)
 }
 
+// FloatFromResult creates a new Float from the results of a function returning (float64, error).
+// It returns a null Float and err if err is not nil, otherwise a valid Float.
+func FloatFromResult(v float64, err error) (Float, error) {
+	if err != nil {
+		return NewFloat(0, false), err
+	}
+	return NewFloat(v, true), nil
+}
+
+// FloatFromZero creates a new Float that will be null if f is 0.
+// It is the opposite of ValueOrZero.
+func FloatFromZero(f float64) Float {
+	return NewFloat(f, f != 0)
+}
+
 // ValueOrZero returns the inner value if valid, otherwise zero.
 func (f Float) ValueOrZero() float64 {
 	if !f.Valid {
@@ -49,10 +64,25 @@ func (f Float) ValueOrZero() float64 {
 	return f.Float64
 }
 
+// ValueOr returns the inner value if valid, otherwise def.
+func (f Float) ValueOr(def float64) float64 {
+	if !f.Valid {
+		return def
+	}
+	return f.Float64
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports number and null input.
+// It supports number, string, and null input.
 // 0 will not be considered a null Float.
+// A blank string is only considered null if NumberEmptyIsNull is set.
+// Numbers are parsed from the JSON text itself, so the result is exact and the same with json.Decoder.UseNumber.
 func (f *Float) UnmarshalJSON(data []byte) error {
+	return reportError(f.unmarshalJSON(data))
+}
+
+func (f *Float) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
 	if bytes.Equal(data, nullBytes) {
 		f.Valid = false
 		return nil
@@ -63,21 +93,28 @@ func (f *Float) UnmarshalJSON(data []byte) error {
 		if errors.As(err, &typeError) {
 			// special case: accept string input
 			if typeError.Value != "string" {
-				return fmt.Errorf("null: JSON input is invalid type (need float or string): %w", err)
+				return newUnmarshalError("Float", fmt.Errorf("JSON input is invalid type (need float or string): %w", err))
 			}
 			var str string
 			if err := json.Unmarshal(data, &str); err != nil {
-				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+				return newUnmarshalError("Float", fmt.Errorf("couldn't unmarshal number string: %w", err))
+			}
+			if str == "" && numberEmptyIsNull() {
+				f.Valid = false
+				return nil
+			}
+			n, err := parseFloat(str)
+			if err == nil {
+				err = checkFinite(str, n)
 			}
-			n, err := strconv.ParseFloat(str, 64)
 			if err != nil {
-				return fmt.Errorf("null: couldn't convert string to float: %w", err)
+				return newUnmarshalError("Float", fmt.Errorf("couldn't convert string to float: %w", err))
 			}
 			f.Float64 = n
 			f.Valid = true
 			return nil
 		}
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		return newUnmarshalError("Float", fmt.Errorf("couldn't unmarshal JSON: %w", err))
 	}
 
 	f.Valid = true
@@ -88,18 +125,35 @@ func (f *Float) UnmarshalJSON(data []byte) error {
 // It will unmarshal to a null Float if the input is blank.
 // It will return an error if the input is not an integer, blank, or "null".
 func (f *Float) UnmarshalText(text []byte) error {
+	return reportError(f.unmarshalText(text))
+}
+
+func (f *Float) unmarshalText(text []byte) error {
 	str := string(text)
 	if str == "" || str == "null" {
 		f.Valid = false
 		return nil
 	}
 	var err error
-	f.Float64, err = strconv.ParseFloat(string(text), 64)
+	f.Float64, err = parseFloat(str)
+	if err == nil {
+		err = checkFinite(str, f.Float64)
+	}
 	if err != nil {
-		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+		f.Valid = false
+		return newUnmarshalError("Float", fmt.Errorf("couldn't unmarshal text: %w", err))
 	}
 	f.Valid = true
-	return err
+	return nil
+}
+
+// checkFinite returns an error if n, parsed from str, is NaN or infinite.
+// MarshalJSON can't encode these, so a value parsed from text couldn't be sent back.
+func checkFinite(str string, n float64) error {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return fmt.Errorf("%q is not a finite number", str)
+	}
+	return nil
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -126,6 +180,48 @@ func (f Float) MarshalText() ([]byte, error) {
 	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
 }
 
+// Scan implements the sql.Scanner interface.
+// Besides numbers, it parses []byte and string input, such as NUMERIC columns returned as text by some drivers,
+// json.Number, as decoded by a json.Decoder with UseNumber,
+// and bool, as returned by SQLite for some expressions, with true as 1 and false as 0.
+func (f *Float) Scan(value interface{}) error {
+	return reportError(f.scan(value))
+}
+
+func (f *Float) scan(value interface{}) error {
+	var err error
+	switch v := scanSource(value).(type) {
+	case bool:
+		f.Float64, f.Valid = 0, true
+		if v {
+			f.Float64 = 1
+		}
+	case json.Number:
+		err = f.scanString(string(v))
+	case []byte:
+		err = f.scanString(string(v))
+	case string:
+		err = f.scanString(v)
+	default:
+		err = f.NullFloat64.Scan(v)
+	}
+	if err != nil {
+		f.Valid = false
+		return newScanError("Float", value, err)
+	}
+	return nil
+}
+
+// scanString parses str for Scan. Like UnmarshalText, it rejects NaN and infinities.
+func (f *Float) scanString(str string) error {
+	n, err := strconv.ParseFloat(str, 64)
+	if err == nil {
+		err = checkFinite(str, n)
+	}
+	f.Float64, f.Valid = n, true
+	return err
+}
+
 // SetValid changes this Float's value and also sets it to be non-null.
 func (f *Float) SetValid(n float64) {
 	f.Float64 = n
@@ -140,12 +236,31 @@ func (f Float) Ptr() *float64 {
 	return &f.Float64
 }
 
+// Unwrap returns this Float as a sql.NullFloat64, for code that needs the standard library type.
+func (f Float) Unwrap() sql.NullFloat64 {
+	return f.NullFloat64
+}
+
+// Reset makes this Float null and clears its value, so a reused Float doesn't keep previous data.
+func (f *Float) Reset() {
+	*f = Float{}
+}
+
 // IsZero returns true for invalid Floats, for future omitempty support (Go 1.4?)
 // A non-null Float with a 0 value will not be considered zero.
 func (f Float) IsZero() bool {
 	return !f.Valid
 }
 
+// AsAny returns nil if this Float is null, otherwise its value as float64.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (f Float) AsAny() interface{} {
+	if !f.Valid {
+		return nil
+	}
+	return f.Float64
+}
+
 // Equal returns true if both floats have the same value or are both null.
 // Warning: calculations using floating point numbers can result in different ways
 // the numbers are stored in memory. Therefore, this function is not suitable to
@@ -154,3 +269,46 @@ func (f Float) IsZero() bool {
 func (f Float) Equal(other Float) bool {
 	return f.Valid == other.Valid && (!f.Valid || f.Float64 == other.Float64)
 }
+
+// EqualNaN is like Equal, but also considers two NaN values equal.
+// Equal follows IEEE 754, where NaN is not equal to anything, not even itself,
+// so rows holding NaN never match when deduplicating or looking up sets. Use EqualNaN for that instead.
+func (f Float) EqualNaN(other Float) bool {
+	if f.Valid && other.Valid && math.IsNaN(f.Float64) && math.IsNaN(other.Float64) {
+		return true
+	}
+	return f.Equal(other)
+}
+
+// Compare returns -1, 0 or +1 depending on whether f is less than, equal to or greater than other.
+// A null Float sorts before all valid ones.
+func (f Float) Compare(other Float) int {
+	if c, ok := compareNull(f.Valid, other.Valid); ok {
+		return c
+	}
+	return compareFloat(f.Float64, other.Float64)
+}
+
+// Less returns true if f sorts before other. A null Float sorts before all valid ones.
+func (f Float) Less(other Float) bool {
+	return f.Compare(other) < 0
+}
+
+// ValueEqual returns true if both floats have the same value, treating null as zero.
+// Unlike Equal, a null Float is ValueEqual to a valid zero.
+func (f Float) ValueEqual(other Float) bool {
+	return f.ValueOrZero() == other.ValueOrZero()
+}
+
+// JSONSchema returns the JSON Schema of this Float's JSON encoding: a number or null.
+func (f Float) JSONSchema() map[string]interface{} {
+	return nullableSchema("number")
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Float as the Go code that creates it.
+func (f Float) GoString() string {
+	if !f.Valid {
+		return `null.NewFloat(0, false)`
+	}
+	return "null.FloatFrom(" + goFloat(f.Float64) + ")"
+}
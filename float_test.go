@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -76,6 +77,33 @@ func TestUnmarshalFloat(t *testing.T) {
 	}
 }
 
+func TestUnmarshalFloatEmptyIsNull(t *testing.T) {
+	defer func(prev bool) { NumberEmptyIsNull = prev }(NumberEmptyIsNull)
+
+	for _, lenient := range []bool{false, true} {
+		NumberEmptyIsNull = lenient
+
+		var blank Float
+		err := json.Unmarshal(floatBlankJSON, &blank)
+		if lenient {
+			maybePanic(err)
+		} else if err == nil {
+			t.Error("expected error for blank string in strict mode")
+		}
+		assertNullFloat(t, blank, "blank string json")
+
+		var null Float
+		err = json.Unmarshal(nullJSON, &null)
+		maybePanic(err)
+		assertNullFloat(t, null, "null json")
+
+		var valid Float
+		err = json.Unmarshal(floatJSON, &valid)
+		maybePanic(err)
+		assertFloat(t, valid, "number json")
+	}
+}
+
 func TestTextUnmarshalFloat(t *testing.T) {
 	var f Float
 	err := f.UnmarshalText([]byte("1.2345"))
@@ -174,6 +202,25 @@ func TestFloatScan(t *testing.T) {
 	maybePanic(err)
 	assertFloat(t, sf, "scanned string float")
 
+	var bf Float
+	err = bf.Scan([]byte("1.2345"))
+	maybePanic(err)
+	assertFloat(t, bf, "scanned []byte float")
+
+	var numeric Float
+	err = numeric.Scan([]byte("3.14"))
+	maybePanic(err)
+	if !numeric.Valid || numeric.Float64 != 3.14 {
+		t.Errorf("bad scanned NUMERIC: %v", numeric.Float64)
+	}
+
+	invalid := FloatFrom(1)
+	err = invalid.Scan([]byte("abc"))
+	if err == nil {
+		t.Error("expected error scanning []byte(\"abc\")")
+	}
+	assertNullFloat(t, invalid, "scanned invalid []byte")
+
 	var null Float
 	err = null.Scan(nil)
 	maybePanic(err)
@@ -192,6 +239,30 @@ func TestFloatInfNaN(t *testing.T) {
 	if err == nil {
 		t.Error("expected error for Inf, got nil")
 	}
+
+	// JSON can't hold them, so they are rejected on input too
+	for _, in := range []string{`"NaN"`, `"Inf"`, `"-infinity"`} {
+		var f Float
+		if err := json.Unmarshal([]byte(in), &f); err == nil {
+			t.Errorf("expected error for %s", in)
+		}
+		assertNullFloat(t, f, "non-finite json string")
+	}
+	for _, in := range []string{"NaN", "Inf", "-infinity"} {
+		f := FloatFrom(1)
+		if err := f.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("expected error for text %s", in)
+		}
+		assertNullFloat(t, f, "non-finite text")
+
+		for _, src := range []interface{}{in, []byte(in), json.Number(in)} {
+			f := FloatFrom(1)
+			if err := f.Scan(src); err == nil {
+				t.Errorf("expected error scanning %#v", src)
+			}
+			assertNullFloat(t, f, "non-finite scan")
+		}
+	}
 }
 
 func TestFloatValueOrZero(t *testing.T) {
@@ -232,6 +303,36 @@ func TestFloatEqual(t *testing.T) {
 	assertFloatEqualIsFalse(t, f1, f2)
 }
 
+func TestFloatValueOr(t *testing.T) {
+	valid := NewFloat(1.2345, true)
+	if valid.ValueOr(4.2) != 1.2345 {
+		t.Error("unexpected ValueOr", valid.ValueOr(4.2))
+	}
+
+	invalid := NewFloat(1.2345, false)
+	if invalid.ValueOr(4.2) != 4.2 {
+		t.Error("unexpected ValueOr", invalid.ValueOr(4.2))
+	}
+}
+
+func TestFloatValueEqual(t *testing.T) {
+	null := NewFloat(1.2345, false)
+	zero := NewFloat(0, true)
+	valid := NewFloat(1.2345, true)
+	if !null.ValueEqual(zero) || !zero.ValueEqual(null) {
+		t.Error("ValueEqual() of null and valid zero should be true")
+	}
+	if null.Equal(zero) {
+		t.Error("Equal() of null and valid zero should be false")
+	}
+	if !null.ValueEqual(null) || !valid.ValueEqual(valid) {
+		t.Error("ValueEqual() of the same values should be true")
+	}
+	if valid.ValueEqual(zero) || null.ValueEqual(valid) {
+		t.Error("ValueEqual() of different values should be false")
+	}
+}
+
 func assertFloat(t *testing.T, f Float, from string) {
 	if f.Float64 != 1.2345 {
 		t.Errorf("bad %s float: %f ≠ %f\n", from, f.Float64, 1.2345)
@@ -260,3 +361,63 @@ func assertFloatEqualIsFalse(t *testing.T, a, b Float) {
 		t.Errorf("Equal() of Float{%v, Valid:%t} and Float{%v, Valid:%t} should return false", a.Float64, a.Valid, b.Float64, b.Valid)
 	}
 }
+
+func TestFloatUseNumber(t *testing.T) {
+	var v struct {
+		F   Float
+		Any interface{}
+	}
+	dec := json.NewDecoder(strings.NewReader(`{"F":1.2345,"Any":1.2345}`))
+	dec.UseNumber()
+	err := dec.Decode(&v)
+	maybePanic(err)
+	assertFloat(t, v.F, "UseNumber float")
+
+	var scanned Float
+	err = scanned.Scan(v.Any)
+	maybePanic(err)
+	assertFloat(t, scanned, "scanned json.Number")
+
+	var bad Float
+	if err := bad.Scan(json.Number("abc")); err == nil {
+		t.Error("expected error")
+	}
+	assertNullFloat(t, bad, "scanned bad json.Number")
+}
+
+func TestFloatEqualNaN(t *testing.T) {
+	nan, num, null := FloatFrom(math.NaN()), FloatFrom(1.5), NewFloat(math.NaN(), false)
+	tests := []struct {
+		a, b  Float
+		equal bool
+	}{
+		{nan, FloatFrom(math.NaN()), true},
+		{nan, num, false},
+		{num, nan, false},
+		{num, FloatFrom(1.5), true},
+		{nan, null, false},
+		{null, NewFloat(0, false), true},
+	}
+	for _, test := range tests {
+		if got := test.a.EqualNaN(test.b); got != test.equal {
+			t.Errorf("%v.EqualNaN(%v) = %v, want %v", test.a, test.b, got, test.equal)
+		}
+	}
+	if nan.Equal(nan) {
+		t.Error("Equal() should keep IEEE semantics for NaN")
+	}
+}
+
+func TestFloatScanBool(t *testing.T) {
+	for _, test := range []struct {
+		in   bool
+		want float64
+	}{{true, 1}, {false, 0}} {
+		f := NewFloat(42, false)
+		err := f.Scan(test.in)
+		maybePanic(err)
+		if !f.Valid || f.Float64 != test.want {
+			t.Errorf("Scan(%v) = %#v, want %v", test.in, f, test.want)
+		}
+	}
+}
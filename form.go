@@ -0,0 +1,125 @@
+package null
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+)
+
+// FormBool is a nullable bool for HTML form values.
+// It is a Bool that also accepts "on" and "off" as text, as sent by checkboxes.
+type FormBool struct {
+	Bool
+}
+
+// NewFormBool creates a new FormBool
+func NewFormBool(b bool, valid bool) FormBool {
+	return FormBool{Bool: NewBool(b, valid)}
+}
+
+// FormBoolFrom creates a new FormBool that will always be valid.
+func FormBoolFrom(b bool) FormBool {
+	return NewFormBool(b, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports the same input as Bool.
+func (b *FormBool) UnmarshalJSON(data []byte) error {
+	return reportError(b.unmarshalJSON(data))
+}
+
+func (b *FormBool) unmarshalJSON(data []byte) error {
+	return retypeError("FormBool", b.Bool.unmarshalJSON(data))
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports the same input as Bool.
+func (b *FormBool) Scan(value interface{}) error {
+	return reportError(b.scan(value))
+}
+
+func (b *FormBool) scan(value interface{}) error {
+	return retypeError("FormBool", b.Bool.scan(value))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null FormBool if the input is blank.
+// It accepts "on" and "off" as well as everything strconv.ParseBool accepts.
+func (b *FormBool) UnmarshalText(text []byte) error {
+	return reportError(b.unmarshalText(text))
+}
+
+func (b *FormBool) unmarshalText(text []byte) error {
+	str := string(text)
+	switch str {
+	case "":
+		b.Valid = false
+		return nil
+	case "on":
+		b.SetValid(true)
+		return nil
+	case "off":
+		b.SetValid(false)
+		return nil
+	}
+	v, err := strconv.ParseBool(str)
+	if err != nil {
+		b.Valid = false
+		return newUnmarshalError("FormBool", fmt.Errorf("couldn't unmarshal text: %w", err))
+	}
+	b.SetValid(v)
+	return nil
+}
+
+// Equal returns true if both FormBools have the same value or are both null.
+func (b FormBool) Equal(other FormBool) bool {
+	return b.Bool.Equal(other.Bool)
+}
+
+// DecodeForm decodes values into the struct v points to.
+// Each exported field implementing encoding.TextUnmarshaler is decoded from the value named by its
+// form tag, or by its Go name if it has none. Fields tagged with `form:"-"` and fields whose
+// name isn't in values are left untouched.
+func DecodeForm(values url.Values, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("null: DecodeForm needs a pointer to a struct")
+	}
+	rv = rv.Elem()
+	typ := rv.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name := field.Tag.Get("form")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if _, ok := values[name]; !ok {
+			continue
+		}
+		u, ok := rv.Field(i).Addr().Interface().(encoding.TextUnmarshaler)
+		if !ok {
+			continue
+		}
+		if err := u.UnmarshalText([]byte(values.Get(name))); err != nil {
+			return fmt.Errorf("null: couldn't decode form field %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this FormBool as the Go code that creates it.
+func (b FormBool) GoString() string {
+	if !b.Valid {
+		return `null.NewFormBool(false, false)`
+	}
+	return "null.FormBoolFrom(" + strconv.FormatBool(b.Bool.Bool) + ")"
+}
@@ -0,0 +1,92 @@
+package null
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestFormBoolUnmarshalText(t *testing.T) {
+	var checked FormBool
+	err := checked.UnmarshalText([]byte("on"))
+	maybePanic(err)
+	assertBool(t, checked.Bool, "checkbox on")
+
+	var off FormBool
+	err = off.UnmarshalText([]byte("off"))
+	maybePanic(err)
+	assertFalseBool(t, off.Bool, "checkbox off")
+
+	var yes FormBool
+	err = yes.UnmarshalText([]byte("true"))
+	maybePanic(err)
+	assertBool(t, yes.Bool, "true")
+
+	var no FormBool
+	err = no.UnmarshalText([]byte("false"))
+	maybePanic(err)
+	assertFalseBool(t, no.Bool, "false")
+
+	blank := FormBoolFrom(true)
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullBool(t, blank.Bool, "blank")
+
+	var invalid FormBool
+	err = invalid.UnmarshalText([]byte("maybe"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullBool(t, invalid.Bool, "invalid")
+}
+
+func TestFormBoolEqual(t *testing.T) {
+	if !FormBoolFrom(true).Equal(FormBoolFrom(true)) || !NewFormBool(true, false).Equal(NewFormBool(false, false)) {
+		t.Error("Equal() should be true")
+	}
+	if FormBoolFrom(true).Equal(FormBoolFrom(false)) || FormBoolFrom(false).Equal(NewFormBool(false, false)) {
+		t.Error("Equal() should be false")
+	}
+}
+
+type formTarget struct {
+	Subscribe FormBool `form:"subscribe"`
+	Terms     FormBool `form:"terms"`
+	Name      String   `form:"name"`
+	Age       Int
+	Skipped   String `form:"-"`
+	Untouched String `form:"untouched"`
+	Other     int    `form:"other"`
+}
+
+func TestDecodeForm(t *testing.T) {
+	values := url.Values{
+		"subscribe": {"on"},
+		"terms":     {""},
+		"name":      {"test"},
+		"Age":       {"12345"},
+		"Skipped":   {"x"},
+		"other":     {"1"},
+	}
+	target := formTarget{Untouched: StringFrom("test")}
+	err := DecodeForm(values, &target)
+	maybePanic(err)
+	assertBool(t, target.Subscribe.Bool, "decoded checkbox")
+	assertNullBool(t, target.Terms.Bool, "decoded empty checkbox")
+	assertStr(t, target.Name, "decoded string")
+	assertInt(t, target.Age, "decoded int")
+	assertNullStr(t, target.Skipped, "skipped field")
+	assertStr(t, target.Untouched, "untouched field")
+	if target.Other != 0 {
+		t.Error("non-TextUnmarshaler field should be ignored")
+	}
+
+	err = DecodeForm(url.Values{"Age": {"abc"}}, &target)
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	err = DecodeForm(values, target)
+	if err == nil {
+		t.Error("expected error for non-pointer")
+	}
+}
@@ -0,0 +1,88 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+// jsonCodec is a pointer to one of this package's types.
+type jsonCodec interface {
+	json.Marshaler
+	json.Unmarshaler
+}
+
+// fuzzTypes returns a new, null value of every type in this package.
+func fuzzTypes() map[string]jsonCodec {
+	return map[string]jsonCodec{
+		"String":          new(String),
+		"Int":             new(Int),
+		"Float":           new(Float),
+		"Bool":            new(Bool),
+		"Time":            new(Time),
+		"Timestamp":       new(Timestamp),
+		"Number":          new(Number),
+		"Percent":         new(Percent),
+		"Semver":          new(Semver),
+		"Color":           new(Color),
+		"FormBool":        new(FormBool),
+		"EnumInt":         new(EnumInt[orderStatus]),
+		"Month":           new(Month),
+		"Int32":           new(Int32),
+		"Int16":           new(Int16),
+		"Uint":            new(Uint),
+		"SecretString":    new(SecretString),
+		"CIString":        new(CIString),
+		"Tagged":          new(Tagged[int]),
+		"Ints":            new(Ints),
+		"Email":           new(Email),
+		"ByteSize":        new(ByteSize),
+		"Phone":           new(Phone),
+		"EncryptedString": new(EncryptedString),
+		"Decimal":         new(Decimal),
+		"Rat":             new(Rat),
+		"Lang":            new(Lang),
+		"Base64":          new(Base64),
+	}
+}
+
+func FuzzUnmarshalJSON(f *testing.F) {
+	for _, seed := range [][]byte{
+		nullJSON, invalidJSON, badObject,
+		stringJSON, blankStringJSON, nullStringJSON,
+		intJSON, intStringJSON, nullIntJSON,
+		floatJSON, floatStringJSON, nullFloatJSON,
+		boolJSON, nullBoolJSON,
+		timeJSON, timeObject,
+		timestampJSON, timestampObject,
+		numberIntJSON, numberFloatJSON,
+		[]byte(`"1.0.0-alpha+001"`), []byte(`"#ff800080"`), []byte(`"shipped"`), []byte(`"March"`),
+		[]byte(`[1,null,3]`), []byte(`"gopher@example.com"`), []byte(`"+1 415 555 2671"`),
+		[]byte(`{"value":42,"valid":true}`), []byte(`"1.5GiB"`),
+		[]byte(`1.356124881e9`), []byte(`-1.5e0`), []byte(`"1e3"`), []byte(`"NaN"`), []byte(`"-Inf"`), []byte(`"-3/4"`), []byte(`"zh-hant-tw"`), []byte(`"aGVsbG8="`), []byte("\xef\xbb\xbf null "),
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for name, v := range fuzzTypes() {
+			if err := v.UnmarshalJSON(data); err != nil {
+				continue
+			}
+			first, err := v.MarshalJSON()
+			if err != nil {
+				t.Fatalf("%s: UnmarshalJSON(%q) succeeded, but MarshalJSON failed: %v", name, data, err)
+			}
+
+			// the first round trip may normalize the input, after that it must be stable
+			again := fuzzTypes()[name]
+			if err := again.UnmarshalJSON(first); err != nil {
+				t.Fatalf("%s: can't unmarshal own output %s (from %q): %v", name, first, data, err)
+			}
+			second, err := again.MarshalJSON()
+			maybePanic(err)
+			if !bytes.Equal(first, second) {
+				t.Errorf("%s: round trip of %q changed %s to %s", name, data, first, second)
+			}
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package null
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// goFloat returns f as a Go expression.
+func goFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "math.NaN()"
+	case math.IsInf(f, 1):
+		return "math.Inf(1)"
+	case math.IsInf(f, -1):
+		return "math.Inf(-1)"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// goTime returns t as a time.Date expression.
+// Locations other than UTC and Local are written as a fixed zone with t's offset.
+func goTime(t time.Time) string {
+	loc := "time.UTC"
+	switch t.Location() {
+	case time.UTC:
+	case time.Local:
+		loc = "time.Local"
+	default:
+		name, offset := t.Zone()
+		loc = fmt.Sprintf("time.FixedZone(%q, %d)", name, offset)
+	}
+	return fmt.Sprintf("time.Date(%d, time.%s, %d, %d, %d, %d, %d, %s)",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
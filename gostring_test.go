@@ -0,0 +1,81 @@
+package null
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGoString(t *testing.T) {
+	tests := []struct {
+		in   fmt.GoStringer
+		want string
+	}{
+		{StringFrom(`a "b"`), `null.StringFrom("a \"b\"")`},
+		{NewString("x", false), `null.NewString("", false)`},
+		{IntFrom(-12345), `null.IntFrom(-12345)`},
+		{NewInt(1, false), `null.NewInt(0, false)`},
+		{FloatFrom(1.5), `null.FloatFrom(1.5)`},
+		{FloatFrom(math.Inf(-1)), `null.FloatFrom(math.Inf(-1))`},
+		{NewFloat(1, false), `null.NewFloat(0, false)`},
+		{BoolFrom(false), `null.BoolFrom(false)`},
+		{NewBool(true, false), `null.NewBool(false, false)`},
+		{TimeFrom(time.Date(2012, 12, 24, 7, 46, 0, 5, time.UTC)),
+			`null.TimeFrom(time.Date(2012, time.December, 24, 7, 46, 0, 5, time.UTC))`},
+		{NewTime(timeValue1, false), `null.NewTime(time.Time{}, false)`},
+		{TimestampFrom(time.Date(2012, 1, 2, 3, 4, 5, 0, time.FixedZone("JST", 9*60*60))),
+			`null.TimestampFrom(time.Date(2012, time.January, 2, 3, 4, 5, 0, time.FixedZone("JST", 32400)))`},
+		{NewTimestamp(timestampValue, false), `null.NewTimestamp(time.Time{}, false)`},
+		{NumberFrom("1.50"), `null.NumberFrom("1.50")`},
+		{NewNumber("1", false), `null.NewNumber("", false)`},
+		{PercentFrom(12.5), `null.PercentFrom(12.5)`},
+		{NewPercent(1, false), `null.NewPercent(0, false)`},
+		{Semver{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Valid: true},
+			`null.Semver{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "", Valid: true}`},
+		{Semver{}, `null.Semver{}`},
+		{NewColor(0x12, 0, 0xab, 0xff, true), `null.NewColor(0x12, 0x00, 0xab, 0xff, true)`},
+		{Color{}, `null.NewColor(0, 0, 0, 0, false)`},
+		{FormBoolFrom(true), `null.FormBoolFrom(true)`},
+		{NewFormBool(true, false), `null.NewFormBool(false, false)`},
+		{EnumIntFrom(orderShipped), `null.EnumIntFrom[null.orderStatus](2)`},
+		{NewEnumInt(orderShipped, false), `null.NewEnumInt[null.orderStatus](0, false)`},
+		{MonthFrom(time.March), `null.MonthFrom(time.March)`},
+		{NewMonth(time.March, false), `null.NewMonth(0, false)`},
+		{Int32From(-5), `null.Int32From(-5)`},
+		{NewInt32(5, false), `null.NewInt32(0, false)`},
+		{Int16From(7), `null.Int16From(7)`},
+		{NewInt16(7, false), `null.NewInt16(0, false)`},
+		{UintFrom(9), `null.UintFrom(9)`},
+		{NewUint(9, false), `null.NewUint(0, false)`},
+		{CIStringFrom("Active"), `null.CIStringFrom("active")`},
+		{NewCIString("x", false), `null.NewCIString("", false)`},
+		{TaggedFrom(42), `null.Tagged[int]{Val: 42, Valid: true}`},
+		{Tagged[int]{}, `null.Tagged[int]{}`},
+		{EncryptedString{String: StringFrom("pii")}, `null.EncryptedString{String: null.StringFrom("pii")}`},
+		{NewEncryptedString(nil, nil), `null.EncryptedString{}`},
+		{Email{Address: "gopher@example.com", Valid: true}, `null.Email{Address: "gopher@example.com", Valid: true}`},
+		{Email{}, `null.Email{}`},
+		{ByteSizeFrom(1024), `null.ByteSizeFrom(1024)`},
+		{ByteSize{}, `null.NewByteSize(0, false)`},
+		{MustPhone("+1 415 555 2671"), `null.Phone{Number: "+14155552671", Valid: true}`},
+		{Phone{}, `null.Phone{}`},
+		{MustDecimal("1.50"), `null.MustDecimal("1.50")`},
+		{Decimal{}, `null.Decimal{}`},
+		{MustRat("2/4"), `null.MustRat("1/2")`},
+		{Rat{}, `null.Rat{}`},
+		{MustLang("en-us"), `null.MustLang("en-US")`},
+		{Lang{}, `null.Lang{}`},
+		{Base64From([]byte("hello")), `null.Base64{Base64: "aGVsbG8=", Valid: true}`},
+		{Base64{}, `null.Base64{}`},
+		{ValidatedString{String: StringFrom("x")}, `null.ValidatedString{String: null.StringFrom("x")}`},
+		{NewValidatedString(nil), `null.ValidatedString{}`},
+		{ValidatedInt{Int: IntFrom(5)}, `null.ValidatedInt{Int: null.IntFrom(5)}`},
+		{NewValidatedInt(nil), `null.ValidatedInt{}`},
+	}
+	for _, test := range tests {
+		if got := fmt.Sprintf("%#v", test.in); got != test.want {
+			t.Errorf("%%#v of %T = %s, want %s", test.in, got, test.want)
+		}
+	}
+}
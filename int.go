@@ -4,11 +4,41 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"strconv"
+	"strings"
 )
 
+// NumberEmptyIsNull makes Int and Float decode a blank JSON string as null.
+// By default a blank JSON string is an error for these types.
+//
+// Deprecated: set Config.NumberEmptyIsNull with SetConfig, which is safe to call while values are decoded.
+// This variable is ignored once SetConfig has been called.
+var NumberEmptyIsNull = false
+
+// IntMarshalLargeAsString makes Int marshal to a JSON string instead of a number
+// when its absolute value is greater than 2^53, the limit up to which JavaScript
+// represents integers exactly. UnmarshalJSON accepts both forms regardless.
+//
+// Deprecated: set Config.IntMarshalLargeAsString with SetConfig, which is safe to call while values are decoded.
+// This variable is ignored once SetConfig has been called.
+var IntMarshalLargeAsString = false
+
+// IntAllowExponent makes Int accept JSON numbers in exponent notation, such as 1e3 or 1.5e1,
+// as long as their value is an integer that fits in an int64.
+// By default only plain integers are accepted.
+//
+// Deprecated: set Config.IntAllowExponent with SetConfig, which is safe to call while values are decoded.
+// This variable is ignored once SetConfig has been called.
+var IntAllowExponent = false
+
+// maxExactFloatInt is 2^53, the largest integer magnitude up to which every integer is exactly representable as a float64.
+const maxExactFloatInt = 1 << 53
+
 // Int is an nullable int64.
 // It does not consider zero values to be null.
 // It will decode to null, not zero, if null.
@@ -39,6 +69,21 @@ func IntFromPtr(i *int64) Int {
 	return NewInt(*i, true)
 }
 
+// IntFromResult creates a new Int from the results of a function returning (int64, error).
+// It returns a null Int and err if err is not nil, otherwise a valid Int.
+func IntFromResult(v int64, err error) (Int, error) {
+	if err != nil {
+		return NewInt(0, false), err
+	}
+	return NewInt(v, true), nil
+}
+
+// IntFromZero creates a new Int that will be null if i is 0.
+// It is the opposite of ValueOrZero.
+func IntFromZero(i int64) Int {
+	return NewInt(i, i != 0)
+}
+
 // ValueOrZero returns the inner value if valid, otherwise zero.
 func (i Int) ValueOrZero() int64 {
 	if !i.Valid {
@@ -47,10 +92,26 @@ func (i Int) ValueOrZero() int64 {
 	return i.Int64
 }
 
+// ValueOr returns the inner value if valid, otherwise def.
+func (i Int) ValueOr(def int64) int64 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int64
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number, string, and null input.
 // 0 will not be considered a null Int.
+// A blank string is only considered null if NumberEmptyIsNull is set.
+// Exponent notation is only supported if IntAllowExponent is set.
+// Numbers are parsed from the JSON text itself, so the result is exact and the same with json.Decoder.UseNumber.
 func (i *Int) UnmarshalJSON(data []byte) error {
+	return reportError(i.unmarshalJSON(data))
+}
+
+func (i *Int) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
 	if bytes.Equal(data, nullBytes) {
 		i.Valid = false
 		return nil
@@ -59,33 +120,101 @@ func (i *Int) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &i.Int64); err != nil {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
+			// special case: accept integral numbers in exponent notation
+			if intAllowExponent() && strings.HasPrefix(typeError.Value, "number") {
+				n, err := parseIntegral(string(data))
+				if err != nil {
+					return newUnmarshalError("Int", err)
+				}
+				i.Int64 = n
+				i.Valid = true
+				return nil
+			}
 			// special case: accept string input
 			if typeError.Value != "string" {
-				return fmt.Errorf("null: JSON input is invalid type (need int or string): %w", err)
+				return newUnmarshalError("Int", fmt.Errorf("JSON input is invalid type (need int or string): %w", err))
 			}
 			var str string
 			if err := json.Unmarshal(data, &str); err != nil {
-				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+				return newUnmarshalError("Int", fmt.Errorf("couldn't unmarshal number string: %w", err))
+			}
+			if str == "" && numberEmptyIsNull() {
+				i.Valid = false
+				return nil
 			}
 			n, err := strconv.ParseInt(str, 10, 64)
 			if err != nil {
-				return fmt.Errorf("null: couldn't convert string to int: %w", err)
+				return newUnmarshalError("Int", fmt.Errorf("couldn't convert string to int: %w", err))
 			}
 			i.Int64 = n
 			i.Valid = true
 			return nil
 		}
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		return newUnmarshalError("Int", fmt.Errorf("couldn't unmarshal JSON: %w", err))
 	}
 
 	i.Valid = true
 	return nil
 }
 
+// Int32 returns the inner value as an int32 and whether this Int is valid.
+// It returns an error wrapping ErrOverflow if the value doesn't fit in an int32.
+func (i Int) Int32() (int32, bool, error) {
+	if err := checkIntRange(i, math.MinInt32, math.MaxInt32, "int32"); err != nil {
+		return 0, true, err
+	}
+	return int32(i.ValueOrZero()), i.Valid, nil
+}
+
+// Int16 returns the inner value as an int16 and whether this Int is valid.
+// It returns an error wrapping ErrOverflow if the value doesn't fit in an int16.
+func (i Int) Int16() (int16, bool, error) {
+	if err := checkIntRange(i, math.MinInt16, math.MaxInt16, "int16"); err != nil {
+		return 0, true, err
+	}
+	return int16(i.ValueOrZero()), i.Valid, nil
+}
+
+// Uint returns the inner value as a uint64 and whether this Int is valid.
+// It returns an error wrapping ErrOverflow if the value is negative.
+func (i Int) Uint() (uint64, bool, error) {
+	if err := checkIntRange(i, 0, math.MaxInt64, "uint"); err != nil {
+		return 0, true, err
+	}
+	return uint64(i.ValueOrZero()), i.Valid, nil
+}
+
+// checkIntRange returns an error wrapping ErrOverflow if i is valid and not within [min, max].
+func checkIntRange(i Int, min, max int64, typ string) error {
+	if i.Valid && (i.Int64 < min || i.Int64 > max) {
+		return fmt.Errorf("%w: %d is out of range for %s", ErrOverflow, i.Int64, typ)
+	}
+	return nil
+}
+
+// parseIntegral parses a JSON number that must have an integer value fitting in an int64.
+func parseIntegral(str string) (int64, error) {
+	r, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return 0, fmt.Errorf("invalid number %q", str)
+	}
+	if !r.IsInt() {
+		return 0, fmt.Errorf("number %q is not an integer", str)
+	}
+	if !r.Num().IsInt64() {
+		return 0, fmt.Errorf("number %q overflows int64", str)
+	}
+	return r.Num().Int64(), nil
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null Int if the input is blank.
 // It will return an error if the input is not an integer, blank, or "null".
 func (i *Int) UnmarshalText(text []byte) error {
+	return reportError(i.unmarshalText(text))
+}
+
+func (i *Int) unmarshalText(text []byte) error {
 	str := string(text)
 	if str == "" || str == "null" {
 		i.Valid = false
@@ -94,7 +223,7 @@ func (i *Int) UnmarshalText(text []byte) error {
 	var err error
 	i.Int64, err = strconv.ParseInt(string(text), 10, 64)
 	if err != nil {
-		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+		return newUnmarshalError("Int", fmt.Errorf("couldn't unmarshal text: %w", err))
 	}
 	i.Valid = true
 	return nil
@@ -102,20 +231,81 @@ func (i *Int) UnmarshalText(text []byte) error {
 
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Int is null.
+// It will encode a string for values beyond ±2^53 if IntMarshalLargeAsString is set.
 func (i Int) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	str := strconv.FormatInt(i.Int64, 10)
+	if intMarshalLargeAsString() && (i.Int64 > maxExactFloatInt || i.Int64 < -maxExactFloatInt) {
+		return []byte(`"` + str + `"`), nil
+	}
+	return []byte(str), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Int is null.
 func (i Int) MarshalText() ([]byte, error) {
+	return i.AppendText(make([]byte, 0, 20))
+}
+
+// AppendText implements encoding.TextAppender, appending the encoding of MarshalText to b.
+// Reusing b avoids the allocation MarshalText makes for every call.
+func (i Int) AppendText(b []byte) ([]byte, error) {
+	if !i.Valid {
+		return b, nil
+	}
+	return strconv.AppendInt(b, i.Int64, 10), nil
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr, so this Int can be used for fields tagged with ",attr".
+// It omits the attribute if this Int is null, instead of writing it with an empty value,
+// and otherwise writes the same value as MarshalText.
+func (i Int) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
 	if !i.Valid {
-		return []byte{}, nil
+		return xml.Attr{}, nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	text, err := i.MarshalText()
+	return xml.Attr{Name: name, Value: string(text)}, err
+}
+
+// Scan implements the sql.Scanner interface.
+// It also accepts json.Number, as decoded by a json.Decoder with UseNumber, without going through a float,
+// and bool, as returned by SQLite for some expressions, with true as 1 and false as 0.
+func (i *Int) Scan(value interface{}) error {
+	return reportError(i.scan(value))
+}
+
+func (i *Int) scan(value interface{}) error {
+	n, err := scanInt(value)
+	if err != nil {
+		i.Valid = false
+		return newScanError("Int", value, err)
+	}
+	*i = n
+	return nil
+}
+
+// scanInt converts value like Int.Scan, for every integer type that scans through Int,
+// so they all accept the same input. The error is returned bare, for callers to wrap with their own type.
+func scanInt(value interface{}) (Int, error) {
+	var (
+		i   Int
+		err error
+	)
+	switch v := scanSource(value).(type) {
+	case json.Number:
+		i.Int64, err = v.Int64()
+		i.Valid = true
+	case bool:
+		i.Int64, i.Valid = 0, true
+		if v {
+			i.Int64 = 1
+		}
+	default:
+		err = i.NullInt64.Scan(v)
+	}
+	return i, err
 }
 
 // SetValid changes this Int's value and also sets it to be non-null.
@@ -132,13 +322,68 @@ func (i Int) Ptr() *int64 {
 	return &i.Int64
 }
 
+// Unwrap returns this Int as a sql.NullInt64, for code that needs the standard library type.
+func (i Int) Unwrap() sql.NullInt64 {
+	return i.NullInt64
+}
+
+// Reset makes this Int null and clears its value, so a reused Int doesn't keep previous data.
+func (i *Int) Reset() {
+	*i = Int{}
+}
+
 // IsZero returns true for invalid Ints, for future omitempty support (Go 1.4?)
 // A non-null Int with a 0 value will not be considered zero.
 func (i Int) IsZero() bool {
 	return !i.Valid
 }
 
+// AsAny returns nil if this Int is null, otherwise its value as int64.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (i Int) AsAny() interface{} {
+	if !i.Valid {
+		return nil
+	}
+	return i.Int64
+}
+
 // Equal returns true if both ints have the same value or are both null.
 func (i Int) Equal(other Int) bool {
 	return i.Valid == other.Valid && (!i.Valid || i.Int64 == other.Int64)
 }
+
+// Compare returns -1, 0 or +1 depending on whether i is less than, equal to or greater than other.
+// A null Int sorts before all valid ones.
+func (i Int) Compare(other Int) int {
+	if c, ok := compareNull(i.Valid, other.Valid); ok {
+		return c
+	}
+	return compareInt(i.Int64, other.Int64)
+}
+
+// Less returns true if i sorts before other. A null Int sorts before all valid ones.
+func (i Int) Less(other Int) bool {
+	return i.Compare(other) < 0
+}
+
+// ValueEqual returns true if both ints have the same value, treating null as zero.
+// Unlike Equal, a null Int is ValueEqual to a valid zero.
+func (i Int) ValueEqual(other Int) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// JSONSchema returns the JSON Schema of this Int's JSON encoding: an integer or null, or also a string if IntMarshalLargeAsString is set.
+func (i Int) JSONSchema() map[string]interface{} {
+	if intMarshalLargeAsString() {
+		return nullableSchema("integer", "string")
+	}
+	return nullableSchema("integer")
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Int as the Go code that creates it.
+func (i Int) GoString() string {
+	if !i.Valid {
+		return `null.NewInt(0, false)`
+	}
+	return "null.IntFrom(" + strconv.FormatInt(i.Int64, 10) + ")"
+}
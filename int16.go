@@ -0,0 +1,185 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// Int16 is a nullable int16.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Input that doesn't fit in an int16 is rejected with an error wrapping ErrOverflow.
+type Int16 struct {
+	sql.NullInt16
+}
+
+// NewInt16 creates a new Int16
+func NewInt16(i int16, valid bool) Int16 {
+	return Int16{
+		NullInt16: sql.NullInt16{
+			Int16: i,
+			Valid: valid,
+		},
+	}
+}
+
+// Int16From creates a new Int16 that will always be valid.
+func Int16From(i int16) Int16 {
+	return NewInt16(i, true)
+}
+
+// Int16FromPtr creates a new Int16 that be null if i is nil.
+func Int16FromPtr(i *int16) Int16 {
+	if i == nil {
+		return NewInt16(0, false)
+	}
+	return NewInt16(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int16) ValueOrZero() int16 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int16
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (i Int16) ValueOr(def int16) int16 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int16
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if the value doesn't fit in an int16.
+func (i *Int16) Scan(value interface{}) error {
+	return reportError(i.scan(value))
+}
+
+func (i *Int16) scan(value interface{}) error {
+	n, err := scanInt(value)
+	if err != nil {
+		i.Valid = false
+		return newScanError("Int16", value, err)
+	}
+	return i.set(n, nil)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports the same input as Int and returns an error if the value doesn't fit in an int16.
+func (i *Int16) UnmarshalJSON(data []byte) error {
+	return reportError(i.unmarshalJSON(data))
+}
+
+func (i *Int16) unmarshalJSON(data []byte) error {
+	var n Int
+	err := n.unmarshalJSON(data)
+	return i.set(n, err)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports the same input as Int and returns an error if the value doesn't fit in an int16.
+func (i *Int16) UnmarshalText(text []byte) error {
+	return reportError(i.unmarshalText(text))
+}
+
+func (i *Int16) unmarshalText(text []byte) error {
+	var n Int
+	err := n.unmarshalText(text)
+	return i.set(n, err)
+}
+
+// set stores n if err is nil and n fits, otherwise it makes i null and returns the error.
+func (i *Int16) set(n Int, err error) error {
+	if err == nil {
+		err = checkIntRange(n, math.MinInt16, math.MaxInt16, "int16")
+	}
+	if err != nil {
+		i.Valid = false
+		return retypeError("Int16", err)
+	}
+	i.Int16, i.Valid = int16(n.Int64), n.Valid
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int16 is null.
+func (i Int16) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(i.Int16)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Int16 is null.
+func (i Int16) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
+}
+
+// SetValid changes this Int16's value and also sets it to be non-null.
+func (i *Int16) SetValid(n int16) {
+	i.Int16 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int16's value, or a nil pointer if this Int16 is null.
+func (i Int16) Ptr() *int16 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int16
+}
+
+// Unwrap returns this Int16 as a sql.NullInt16, for code that needs the standard library type.
+func (i Int16) Unwrap() sql.NullInt16 {
+	return i.NullInt16
+}
+
+// Reset makes this Int16 null and clears its value, so a reused Int16 doesn't keep previous data.
+func (i *Int16) Reset() {
+	*i = Int16{}
+}
+
+// IsZero returns true for invalid Int16s.
+// A non-null Int16 with a 0 value will not be considered zero.
+func (i Int16) IsZero() bool {
+	return !i.Valid
+}
+
+// AsAny returns nil if this Int16 is null, otherwise its value as int16.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (i Int16) AsAny() interface{} {
+	if !i.Valid {
+		return nil
+	}
+	return i.Int16
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Int16) Equal(other Int16) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int16 == other.Int16)
+}
+
+// JSONSchema returns the JSON Schema of this Int16's JSON encoding: an integer in the int16 range, or null.
+func (i Int16) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("integer")
+	schema["minimum"] = math.MinInt16
+	schema["maximum"] = math.MaxInt16
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Int16 as the Go code that creates it.
+func (i Int16) GoString() string {
+	if !i.Valid {
+		return `null.NewInt16(0, false)`
+	}
+	return "null.Int16From(" + strconv.FormatInt(int64(i.Int16), 10) + ")"
+}
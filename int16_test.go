@@ -0,0 +1,40 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInt16Bounds(t *testing.T) {
+	var i Int16
+	err := i.Scan(int64(math.MaxInt16))
+	maybePanic(err)
+	if !i.Equal(Int16From(math.MaxInt16)) {
+		t.Errorf("bad scan of int16 max: %#v", i)
+	}
+
+	err = i.Scan(int64(math.MaxInt16 + 1))
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, not %v", err)
+	}
+	if i.Valid {
+		t.Error("overflowing int16 should be invalid")
+	}
+
+	err = json.Unmarshal([]byte(`-32769`), &i)
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, not %v", err)
+	}
+}
+
+func TestInt16Marshal(t *testing.T) {
+	data, err := json.Marshal(Int16From(-12))
+	maybePanic(err)
+	assertJSONEquals(t, data, "-12", "int16 json marshal")
+
+	data, err = NewInt16(0, false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null int16 text marshal")
+}
@@ -0,0 +1,185 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// Int32 is a nullable int32.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Input that doesn't fit in an int32 is rejected with an error wrapping ErrOverflow.
+type Int32 struct {
+	sql.NullInt32
+}
+
+// NewInt32 creates a new Int32
+func NewInt32(i int32, valid bool) Int32 {
+	return Int32{
+		NullInt32: sql.NullInt32{
+			Int32: i,
+			Valid: valid,
+		},
+	}
+}
+
+// Int32From creates a new Int32 that will always be valid.
+func Int32From(i int32) Int32 {
+	return NewInt32(i, true)
+}
+
+// Int32FromPtr creates a new Int32 that be null if i is nil.
+func Int32FromPtr(i *int32) Int32 {
+	if i == nil {
+		return NewInt32(0, false)
+	}
+	return NewInt32(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int32) ValueOrZero() int32 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int32
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (i Int32) ValueOr(def int32) int32 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int32
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if the value doesn't fit in an int32.
+func (i *Int32) Scan(value interface{}) error {
+	return reportError(i.scan(value))
+}
+
+func (i *Int32) scan(value interface{}) error {
+	n, err := scanInt(value)
+	if err != nil {
+		i.Valid = false
+		return newScanError("Int32", value, err)
+	}
+	return i.set(n, nil)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports the same input as Int and returns an error if the value doesn't fit in an int32.
+func (i *Int32) UnmarshalJSON(data []byte) error {
+	return reportError(i.unmarshalJSON(data))
+}
+
+func (i *Int32) unmarshalJSON(data []byte) error {
+	var n Int
+	err := n.unmarshalJSON(data)
+	return i.set(n, err)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports the same input as Int and returns an error if the value doesn't fit in an int32.
+func (i *Int32) UnmarshalText(text []byte) error {
+	return reportError(i.unmarshalText(text))
+}
+
+func (i *Int32) unmarshalText(text []byte) error {
+	var n Int
+	err := n.unmarshalText(text)
+	return i.set(n, err)
+}
+
+// set stores n if err is nil and n fits, otherwise it makes i null and returns the error.
+func (i *Int32) set(n Int, err error) error {
+	if err == nil {
+		err = checkIntRange(n, math.MinInt32, math.MaxInt32, "int32")
+	}
+	if err != nil {
+		i.Valid = false
+		return retypeError("Int32", err)
+	}
+	i.Int32, i.Valid = int32(n.Int64), n.Valid
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(i.Int32)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Int32 is null.
+func (i Int32) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+}
+
+// SetValid changes this Int32's value and also sets it to be non-null.
+func (i *Int32) SetValid(n int32) {
+	i.Int32 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int32's value, or a nil pointer if this Int32 is null.
+func (i Int32) Ptr() *int32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int32
+}
+
+// Unwrap returns this Int32 as a sql.NullInt32, for code that needs the standard library type.
+func (i Int32) Unwrap() sql.NullInt32 {
+	return i.NullInt32
+}
+
+// Reset makes this Int32 null and clears its value, so a reused Int32 doesn't keep previous data.
+func (i *Int32) Reset() {
+	*i = Int32{}
+}
+
+// IsZero returns true for invalid Int32s.
+// A non-null Int32 with a 0 value will not be considered zero.
+func (i Int32) IsZero() bool {
+	return !i.Valid
+}
+
+// AsAny returns nil if this Int32 is null, otherwise its value as int32.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (i Int32) AsAny() interface{} {
+	if !i.Valid {
+		return nil
+	}
+	return i.Int32
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Int32) Equal(other Int32) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int32 == other.Int32)
+}
+
+// JSONSchema returns the JSON Schema of this Int32's JSON encoding: an integer in the int32 range, or null.
+func (i Int32) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("integer")
+	schema["minimum"] = math.MinInt32
+	schema["maximum"] = math.MaxInt32
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Int32 as the Go code that creates it.
+func (i Int32) GoString() string {
+	if !i.Valid {
+		return `null.NewInt32(0, false)`
+	}
+	return "null.Int32From(" + strconv.FormatInt(int64(i.Int32), 10) + ")"
+}
@@ -0,0 +1,90 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestInt32Bounds(t *testing.T) {
+	max := strconv.Itoa(math.MaxInt32)
+	min := strconv.Itoa(math.MinInt32)
+
+	var i Int32
+	err := json.Unmarshal([]byte(max), &i)
+	maybePanic(err)
+	if !i.Equal(Int32From(math.MaxInt32)) {
+		t.Errorf("bad unmarshal of int32 max: %#v", i)
+	}
+	err = i.UnmarshalText([]byte(min))
+	maybePanic(err)
+	if !i.Equal(Int32From(math.MinInt32)) {
+		t.Errorf("bad unmarshal of int32 min: %#v", i)
+	}
+	err = i.Scan(int64(math.MaxInt32))
+	maybePanic(err)
+	if !i.Equal(Int32From(math.MaxInt32)) {
+		t.Errorf("bad scan of int32 max: %#v", i)
+	}
+
+	for _, over := range []int64{math.MaxInt32 + 1, math.MinInt32 - 1} {
+		i := Int32From(1)
+		err := i.Scan(over)
+		if !errors.Is(err, ErrOverflow) {
+			t.Errorf("expected ErrOverflow scanning %d, not %v", over, err)
+		}
+		if i.Valid {
+			t.Errorf("%d should be invalid", over)
+		}
+
+		i = Int32From(1)
+		err = json.Unmarshal([]byte(strconv.FormatInt(over, 10)), &i)
+		var unmarshalErr *UnmarshalError
+		if !errors.Is(err, ErrOverflow) || !errors.As(err, &unmarshalErr) || unmarshalErr.Type != "Int32" {
+			t.Errorf("expected Int32 overflow error unmarshaling %d, not %v", over, err)
+		}
+		if i.Valid {
+			t.Errorf("%d should be invalid", over)
+		}
+	}
+}
+
+func TestInt32JSON(t *testing.T) {
+	var i Int32
+	err := json.Unmarshal(intStringJSON, &i)
+	maybePanic(err)
+	if !i.Equal(Int32From(12345)) {
+		t.Errorf("bad unmarshal of int string: %#v", i)
+	}
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "int32 json marshal")
+
+	err = json.Unmarshal(nullJSON, &i)
+	maybePanic(err)
+	if i.Valid {
+		t.Error("null json should be invalid")
+	}
+	data, err = json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null int32 json marshal")
+
+	if err := json.Unmarshal(boolJSON, &i); err == nil {
+		t.Error("expected error for bool json")
+	}
+}
+
+func TestInt32Value(t *testing.T) {
+	v, err := Int32From(12345).Value()
+	maybePanic(err)
+	if v != int64(12345) {
+		t.Errorf("bad value: %#v", v)
+	}
+	v, err = NewInt32(12345, false).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null value should be nil, not %#v", v)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -86,6 +87,43 @@ func TestUnmarshalNonIntegerNumber(t *testing.T) {
 	}
 }
 
+func TestUnmarshalIntExponent(t *testing.T) {
+	defer func(prev bool) { IntAllowExponent = prev }(IntAllowExponent)
+
+	IntAllowExponent = false
+	var strict Int
+	if err := json.Unmarshal([]byte(`1e3`), &strict); err == nil {
+		t.Error("expected error for exponent notation by default")
+	}
+
+	IntAllowExponent = true
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{`1e3`, 1000},
+		{`1.5e1`, 15},
+		{`-2E2`, -200},
+		{`12345`, 12345},
+		{`1.2345e4`, 12345},
+	}
+	for _, test := range tests {
+		var i Int
+		err := json.Unmarshal([]byte(test.in), &i)
+		maybePanic(err)
+		if !i.Valid || i.Int64 != test.want {
+			t.Errorf("bad %s: %d ≠ %d", test.in, i.Int64, test.want)
+		}
+	}
+
+	for _, bad := range []string{`1.7e0`, `1.5`, `1e19`, `true`} {
+		var i Int
+		if err := json.Unmarshal([]byte(bad), &i); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+	}
+}
+
 func TestUnmarshalInt64Overflow(t *testing.T) {
 	int64Overflow := uint64(math.MaxInt64)
 
@@ -138,6 +176,42 @@ func TestMarshalInt(t *testing.T) {
 	assertJSONEquals(t, data, "null", "null json marshal")
 }
 
+func TestMarshalIntLargeAsString(t *testing.T) {
+	defer func(prev bool) { IntMarshalLargeAsString = prev }(IntMarshalLargeAsString)
+
+	const limit = 1 << 53
+	tests := []struct {
+		in     int64
+		strict string
+		large  string
+	}{
+		{12345, "12345", "12345"},
+		{limit, "9007199254740992", "9007199254740992"},
+		{-limit, "-9007199254740992", "-9007199254740992"},
+		{limit + 1, "9007199254740993", `"9007199254740993"`},
+		{-limit - 1, "-9007199254740993", `"-9007199254740993"`},
+	}
+	for _, test := range tests {
+		for _, large := range []bool{false, true} {
+			IntMarshalLargeAsString = large
+			want := test.strict
+			if large {
+				want = test.large
+			}
+			data, err := json.Marshal(IntFrom(test.in))
+			maybePanic(err)
+			assertJSONEquals(t, data, want, "large int json marshal")
+
+			var i Int
+			err = json.Unmarshal(data, &i)
+			maybePanic(err)
+			if !i.Valid || i.Int64 != test.in {
+				t.Errorf("bad round trip: %d ≠ %d", i.Int64, test.in)
+			}
+		}
+	}
+}
+
 func TestMarshalIntText(t *testing.T) {
 	i := IntFrom(12345)
 	data, err := i.MarshalText()
@@ -151,6 +225,44 @@ func TestMarshalIntText(t *testing.T) {
 	assertJSONEquals(t, data, "", "null text marshal")
 }
 
+func TestIntAppendText(t *testing.T) {
+	buf := []byte("id=")
+	buf, err := IntFrom(-12345).AppendText(buf)
+	maybePanic(err)
+	assertJSONEquals(t, buf, "id=-12345", "append text")
+
+	buf, err = NewInt(1, false).AppendText(buf[:3])
+	maybePanic(err)
+	assertJSONEquals(t, buf, "id=", "append null text")
+}
+
+func TestUnmarshalIntEmptyIsNull(t *testing.T) {
+	defer func(prev bool) { NumberEmptyIsNull = prev }(NumberEmptyIsNull)
+
+	for _, lenient := range []bool{false, true} {
+		NumberEmptyIsNull = lenient
+
+		var blank Int
+		err := json.Unmarshal(floatBlankJSON, &blank)
+		if lenient {
+			maybePanic(err)
+		} else if err == nil {
+			t.Error("expected error for blank string in strict mode")
+		}
+		assertNullInt(t, blank, "blank string json")
+
+		var null Int
+		err = json.Unmarshal(nullJSON, &null)
+		maybePanic(err)
+		assertNullInt(t, null, "null json")
+
+		var valid Int
+		err = json.Unmarshal(intJSON, &valid)
+		maybePanic(err)
+		assertInt(t, valid, "number json")
+	}
+}
+
 func TestIntPointer(t *testing.T) {
 	i := IntFrom(12345)
 	ptr := i.Ptr()
@@ -239,6 +351,67 @@ func TestIntEqual(t *testing.T) {
 	assertIntEqualIsFalse(t, int1, int2)
 }
 
+func TestIntValueOr(t *testing.T) {
+	valid := NewInt(12345, true)
+	if valid.ValueOr(42) != 12345 {
+		t.Error("unexpected ValueOr", valid.ValueOr(42))
+	}
+
+	invalid := NewInt(12345, false)
+	if invalid.ValueOr(42) != 42 {
+		t.Error("unexpected ValueOr", invalid.ValueOr(42))
+	}
+}
+
+func TestIntValueEqual(t *testing.T) {
+	null := NewInt(12345, false)
+	zero := NewInt(0, true)
+	valid := NewInt(12345, true)
+	if !null.ValueEqual(zero) || !zero.ValueEqual(null) {
+		t.Error("ValueEqual() of null and valid zero should be true")
+	}
+	if null.Equal(zero) {
+		t.Error("Equal() of null and valid zero should be false")
+	}
+	if !null.ValueEqual(null) || !valid.ValueEqual(valid) {
+		t.Error("ValueEqual() of the same values should be true")
+	}
+	if valid.ValueEqual(zero) || null.ValueEqual(valid) {
+		t.Error("ValueEqual() of different values should be false")
+	}
+}
+
+func TestIntSizedConversions(t *testing.T) {
+	i32, valid, err := IntFrom(math.MaxInt32).Int32()
+	if err != nil || !valid || i32 != math.MaxInt32 {
+		t.Errorf("Int32() of int32 max = %d, %t, %v", i32, valid, err)
+	}
+	if _, _, err := IntFrom(math.MaxInt32 + 1).Int32(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow from Int32(), not %v", err)
+	}
+
+	i16, valid, err := IntFrom(math.MinInt16).Int16()
+	if err != nil || !valid || i16 != math.MinInt16 {
+		t.Errorf("Int16() of int16 min = %d, %t, %v", i16, valid, err)
+	}
+	if _, _, err := IntFrom(math.MinInt16 - 1).Int16(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow from Int16(), not %v", err)
+	}
+
+	u, valid, err := IntFrom(12345).Uint()
+	if err != nil || !valid || u != 12345 {
+		t.Errorf("Uint() = %d, %t, %v", u, valid, err)
+	}
+	if _, _, err := IntFrom(-1).Uint(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow from Uint(), not %v", err)
+	}
+
+	null := NewInt(math.MaxInt64, false)
+	if i32, valid, err := null.Int32(); i32 != 0 || valid || err != nil {
+		t.Errorf("Int32() of null = %d, %t, %v", i32, valid, err)
+	}
+}
+
 func assertInt(t *testing.T, i Int, from string) {
 	if i.Int64 != 12345 {
 		t.Errorf("bad %s int: %d ≠ %d\n", from, i.Int64, 12345)
@@ -267,3 +440,48 @@ func assertIntEqualIsFalse(t *testing.T, a, b Int) {
 		t.Errorf("Equal() of Int{%v, Valid:%t} and Int{%v, Valid:%t} should return false", a.Int64, a.Valid, b.Int64, b.Valid)
 	}
 }
+
+func TestIntUseNumber(t *testing.T) {
+	// 2^53 + 1 can't be represented exactly by a float64
+	const big = 9007199254740993
+	var v struct {
+		ID   Int
+		Null Int
+		Any  interface{}
+	}
+	dec := json.NewDecoder(strings.NewReader(`{"ID":9007199254740993,"Null":null,"Any":9007199254740993}`))
+	dec.UseNumber()
+	err := dec.Decode(&v)
+	maybePanic(err)
+	if !v.ID.Valid || v.ID.Int64 != big {
+		t.Errorf("bad decoded Int: %#v", v.ID)
+	}
+	assertNullInt(t, v.Null, "UseNumber null")
+
+	var scanned Int
+	err = scanned.Scan(v.Any)
+	maybePanic(err)
+	if !scanned.Valid || scanned.Int64 != big {
+		t.Errorf("bad scanned json.Number: %#v", scanned)
+	}
+
+	var bad Int
+	if err := bad.Scan(json.Number("1.5")); err == nil {
+		t.Error("expected error")
+	}
+	assertNullInt(t, bad, "scanned fractional json.Number")
+}
+
+func TestIntScanBool(t *testing.T) {
+	for _, test := range []struct {
+		in   bool
+		want int64
+	}{{true, 1}, {false, 0}} {
+		i := NewInt(42, false)
+		err := i.Scan(test.in)
+		maybePanic(err)
+		if !i.Valid || i.Int64 != test.want {
+			t.Errorf("Scan(%v) = %#v, want %d", test.in, i, test.want)
+		}
+	}
+}
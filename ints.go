@@ -0,0 +1,144 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Ints is a nullable slice of nullable ints.
+// A nil Ints is null: it marshals to JSON null and is stored as SQL NULL,
+// while an empty, non-nil Ints marshals to [] and is stored as an empty array.
+// In SQL it is stored as a PostgreSQL array literal, such as {1,NULL,3}.
+type Ints []Int
+
+// Reset sets this Ints to nil, releasing its elements, so a reused value doesn't keep previous data.
+func (s *Ints) Reset() {
+	*s = nil
+}
+
+// IsZero returns true for nil Ints. Empty Ints are not considered zero.
+func (s Ints) IsZero() bool {
+	return s == nil
+}
+
+// AsAny returns nil if s is nil, otherwise a []interface{} holding the AsAny of each element.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (s Ints) AsAny() interface{} {
+	if s == nil {
+		return nil
+	}
+	out := make([]interface{}, len(s))
+	for i, v := range s {
+		out[i] = v.AsAny()
+	}
+	return out
+}
+
+// JSONSchema returns the JSON Schema of this Ints's JSON encoding: an array of integers or nulls, or null.
+func (s Ints) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("array")
+	schema["items"] = nullableSchema("integer")
+	return schema
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if s is nil, and [] if s is empty.
+func (s Ints) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal([]Int(s))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports arrays of Int input, and null input, which unmarshals to nil.
+func (s *Ints) UnmarshalJSON(data []byte) error {
+	return reportError(s.unmarshalJSON(data))
+}
+
+func (s *Ints) unmarshalJSON(data []byte) error {
+	ints, err := unmarshalInts(trimJSON(data))
+	if err != nil {
+		return newUnmarshalError("Ints", err)
+	}
+	*s = ints
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It stores a PostgreSQL array literal, or NULL if s is nil.
+func (s Ints) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, v := range s {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		if !v.Valid {
+			sb.WriteString("NULL")
+			continue
+		}
+		sb.WriteString(strconv.FormatInt(v.Int64, 10))
+	}
+	sb.WriteByte('}')
+	return sb.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports one-dimensional PostgreSQL array literals as string or []byte, and nil input.
+func (s *Ints) Scan(value interface{}) error {
+	return reportError(s.scan(value))
+}
+
+func (s *Ints) scan(value interface{}) error {
+	var str string
+	switch v := scanSource(value).(type) {
+	case nil:
+		*s = nil
+		return nil
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return newScanError("Ints", value, errUnsupportedScanType)
+	}
+
+	ints, err := parseIntArray(str)
+	if err != nil {
+		return newScanError("Ints", value, err)
+	}
+	*s = ints
+	return nil
+}
+
+// parseIntArray parses a one-dimensional PostgreSQL array literal of integers.
+func parseIntArray(str string) (Ints, error) {
+	if len(str) < 2 || str[0] != '{' || str[len(str)-1] != '}' {
+		return nil, fmt.Errorf("invalid array %q", str)
+	}
+	inner := str[1 : len(str)-1]
+	if inner == "" {
+		return Ints{}, nil
+	}
+	elems := strings.Split(inner, ",")
+	out := make(Ints, len(elems))
+	for i, elem := range elems {
+		if strings.EqualFold(elem, "NULL") {
+			continue
+		}
+		n, err := strconv.ParseInt(elem, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid array element " + strconv.Quote(elem))
+		}
+		out[i] = IntFrom(n)
+	}
+	return out, nil
+}
@@ -0,0 +1,110 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+)
+
+func TestIntsJSON(t *testing.T) {
+	tests := []struct {
+		in   Ints
+		want string
+	}{
+		{nil, "null"},
+		{Ints{}, "[]"},
+		{Ints{IntFrom(1), NewInt(0, false), IntFrom(3)}, "[1,null,3]"},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(test.in)
+		maybePanic(err)
+		assertJSONEquals(t, data, test.want, "ints json marshal")
+
+		var back Ints
+		err = json.Unmarshal(data, &back)
+		maybePanic(err)
+		assertIntsEqual(t, back, test.in, "ints json round trip")
+	}
+
+	type record struct {
+		IDs Ints `json:"ids"`
+	}
+	data, err := json.Marshal(record{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"ids":null}`, "nil ints field")
+
+	var r record
+	if err := json.Unmarshal([]byte(`{"ids":[1,"x"]}`), &r); err == nil {
+		t.Error("expected error")
+	}
+
+	var s Ints
+	err = s.UnmarshalJSON([]byte(`{}`))
+	if want := "null: Ints: input is not an array"; err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %q", err, want)
+	}
+}
+
+func TestIntsSQL(t *testing.T) {
+	tests := []struct {
+		in   Ints
+		want interface{}
+	}{
+		{nil, nil},
+		{Ints{}, "{}"},
+		{Ints{IntFrom(1), NewInt(0, false), IntFrom(-3)}, "{1,NULL,-3}"},
+	}
+	for _, test := range tests {
+		v, err := test.in.Value()
+		maybePanic(err)
+		if v != test.want {
+			t.Errorf("Value() = %#v, want %#v", v, test.want)
+		}
+
+		var back Ints
+		err = back.Scan(v)
+		maybePanic(err)
+		assertIntsEqual(t, back, test.in, "ints sql round trip")
+	}
+
+	var fromBytes Ints
+	err := fromBytes.Scan([]byte("{12345,null}"))
+	maybePanic(err)
+	assertIntsEqual(t, fromBytes, Ints{IntFrom(12345), NewInt(0, false)}, "scanned []byte")
+
+	// the driver reuses RawBytes, so Ints must not keep pointing into it
+	buf := sql.RawBytes("{1,2}")
+	var fromRaw Ints
+	err = fromRaw.Scan(buf)
+	maybePanic(err)
+	copy(buf, "{3,4}")
+	assertIntsEqual(t, fromRaw, Ints{IntFrom(1), IntFrom(2)}, "scanned RawBytes")
+
+	str := "{5}"
+	var fromPtr Ints
+	err = fromPtr.Scan(&str)
+	maybePanic(err)
+	assertIntsEqual(t, fromPtr, Ints{IntFrom(5)}, "scanned *string")
+
+	for _, bad := range []interface{}{"1,2", "{1,x}", "{{1}}", int64(1)} {
+		var s Ints
+		if err := s.Scan(bad); err == nil {
+			t.Errorf("expected error scanning %#v", bad)
+		}
+	}
+}
+
+func assertIntsEqual(t *testing.T, got, want Ints, from string) {
+	t.Helper()
+	if (got == nil) != (want == nil) {
+		t.Fatalf("%s: got %#v, want %#v", from, got, want)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("%s: bad length %d ≠ %d", from, len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("%s: element %d is %#v, want %#v", from, i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/text/language"
+)
+
+// Lang is a nullable BCP 47 language tag, such as en-US or zh-Hant-TW, held as a language.Tag
+// from golang.org/x/text so it can be used for matching and display names.
+// Input is parsed with language.Parse, which accepts underscores as separators, rejects subtags
+// missing from the IANA registry, and canonicalizes the tag, so en-us becomes en-US and i-klingon becomes tlh.
+// It will marshal to null if null.
+type Lang struct {
+	Tag   language.Tag
+	Valid bool
+}
+
+// LangFrom canonicalizes s and returns a valid Lang.
+// It returns an error and a null Lang if s is not a well-formed language tag.
+func LangFrom(s string) (Lang, error) {
+	l, err := parseLang(s)
+	if err != nil {
+		return Lang{}, newUnmarshalError("Lang", err)
+	}
+	return l, nil
+}
+
+// MustLang is like LangFrom but panics if s is not a well-formed language tag.
+// It simplifies initializing fixtures and package-level variables, like regexp.MustCompile.
+func MustLang(s string) Lang {
+	l, err := LangFrom(s)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// String returns the canonical tag, or a blank string if this Lang is null.
+func (l Lang) String() string {
+	if !l.Valid {
+		return ""
+	}
+	return l.Tag.String()
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil input, and canonicalizes the tag.
+func (l *Lang) Scan(value interface{}) error {
+	return reportError(l.scan(value))
+}
+
+func (l *Lang) scan(value interface{}) error {
+	var err error
+	switch x := scanSource(value).(type) {
+	case nil:
+		*l = Lang{}
+		return nil
+	case string:
+		*l, err = parseLang(x)
+	case []byte:
+		*l, err = parseLang(string(x))
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		*l = Lang{}
+		return newScanError("Lang", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It stores the canonical tag.
+func (l Lang) Value() (driver.Value, error) {
+	if !l.Valid {
+		return nil, nil
+	}
+	return l.Tag.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (l *Lang) UnmarshalJSON(data []byte) error {
+	return reportError(l.unmarshalJSON(data))
+}
+
+func (l *Lang) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*l = Lang{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		*l = Lang{}
+		return newUnmarshalError("Lang", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	parsed, err := parseLang(str)
+	if err != nil {
+		*l = Lang{}
+		return newUnmarshalError("Lang", err)
+	}
+	*l = parsed
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Lang if the input is blank.
+func (l *Lang) UnmarshalText(text []byte) error {
+	return reportError(l.unmarshalText(text))
+}
+
+func (l *Lang) unmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*l = Lang{}
+		return nil
+	}
+	parsed, err := parseLang(string(text))
+	if err != nil {
+		*l = Lang{}
+		return newUnmarshalError("Lang", err)
+	}
+	*l = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Lang is null.
+func (l Lang) MarshalJSON() ([]byte, error) {
+	if !l.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(l.Tag.String())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Lang is null.
+func (l Lang) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// Reset makes this Lang null and clears its value, so a reused Lang doesn't keep previous data.
+func (l *Lang) Reset() {
+	*l = Lang{}
+}
+
+// IsZero returns true for null Langs.
+func (l Lang) IsZero() bool {
+	return !l.Valid
+}
+
+// AsAny returns nil if this Lang is null, otherwise the canonical tag as a string.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (l Lang) AsAny() interface{} {
+	if !l.Valid {
+		return nil
+	}
+	return l.Tag.String()
+}
+
+// Equal returns true if both Langs have the same canonical tag or are both null.
+func (l Lang) Equal(other Lang) bool {
+	return l.Valid == other.Valid && (!l.Valid || l.Tag.String() == other.Tag.String())
+}
+
+// JSONSchema returns the JSON Schema of this Lang's JSON encoding: a BCP 47 language tag string or null.
+func (l Lang) JSONSchema() map[string]interface{} {
+	return nullableSchema("string")
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Lang as the Go code that creates it.
+func (l Lang) GoString() string {
+	if !l.Valid {
+		return "null.Lang{}"
+	}
+	return "null.MustLang(" + strconv.Quote(l.Tag.String()) + ")"
+}
+
+// parseLang parses and canonicalizes s with language.Parse.
+func parseLang(s string) (Lang, error) {
+	tag, err := language.Parse(s)
+	if err != nil {
+		return Lang{}, fmt.Errorf("invalid language tag %q: %w", s, err)
+	}
+	return Lang{Tag: tag, Valid: true}, nil
+}
@@ -0,0 +1,103 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestLangCanonicalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"en", "en"},
+		{"en-us", "en-US"},
+		{"EN_us", "en-US"},
+		{"zh-hant-tw", "zh-Hant-TW"},
+		{"es-419", "es-419"},
+		{"de-CH-1996", "de-CH-1996"},
+		{"sl-rozaj-biske", "sl-rozaj-biske"},
+		{"zh-yue-HK", "yue-HK"},
+		{"en-US-u-ca-gregory", "en-US-u-ca-gregory"},
+		{"en-US-X-Twain", "en-US-x-twain"},
+		{"x-whatever", "x-whatever"},
+		{"i-klingon", "tlh"},
+	}
+	for _, test := range tests {
+		var l Lang
+		err := json.Unmarshal([]byte(`"`+test.in+`"`), &l)
+		maybePanic(err)
+		if !l.Valid || l.String() != test.want {
+			t.Errorf("bad unmarshal of %q: %#v", test.in, l)
+		}
+
+		data, err := json.Marshal(l)
+		maybePanic(err)
+		assertJSONEquals(t, data, `"`+test.want+`"`, "lang json marshal")
+
+		var scanned Lang
+		err = scanned.Scan([]byte(test.in))
+		maybePanic(err)
+		if !scanned.Equal(l) {
+			t.Errorf("bad scan of %q: %#v", test.in, scanned)
+		}
+		v, err := scanned.Value()
+		maybePanic(err)
+		if v != test.want {
+			t.Errorf("bad value: %#v", v)
+		}
+	}
+}
+
+func TestLangInvalid(t *testing.T) {
+	for _, in := range []string{"e", "en-", "-en", "en--US", "en-US-a", "en-x", "x", "qq", "toolongtag", "en-Ü", "1en", "en US"} {
+		l := MustLang("en")
+		if err := l.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+		if l.Valid {
+			t.Errorf("%q should be invalid", in)
+		}
+		if _, err := LangFrom(in); err == nil {
+			t.Errorf("expected error from LangFrom(%q)", in)
+		}
+		if err := l.Scan(in); err == nil {
+			t.Errorf("expected error scanning %q", in)
+		}
+	}
+	assertPanics(t, func() { MustLang("en--US") }, "MustLang")
+}
+
+func TestLangNull(t *testing.T) {
+	var l Lang
+	err := json.Unmarshal(nullJSON, &l)
+	maybePanic(err)
+	data, err := json.Marshal(l)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null lang json marshal")
+
+	err = l.Scan(nil)
+	maybePanic(err)
+	if v, err := l.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+	if !l.Equal(Lang{}) || l.Equal(MustLang("en")) {
+		t.Error("bad Equal for null Lang")
+	}
+	if err := l.Scan(int64(1)); err == nil {
+		t.Error("expected error scanning an int")
+	}
+}
+
+func TestLangTag(t *testing.T) {
+	l := MustLang("en-us")
+	if l.Tag != language.AmericanEnglish {
+		t.Errorf("bad tag: %v", l.Tag)
+	}
+	matcher := language.NewMatcher([]language.Tag{language.German, language.English})
+	if _, i, _ := matcher.Match(l.Tag); i != 1 {
+		t.Errorf("en-US should match English, got index %d", i)
+	}
+}
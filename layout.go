@@ -0,0 +1,79 @@
+package null
+
+import (
+	"fmt"
+	"time"
+)
+
+// defaultTimeLayouts are the layouts Time accepts unless SetTimeLayouts changes them.
+// RFC3339Nano also matches RFC 3339 times without fractional seconds, and the last layout
+// matches SQL style times with or without them. Named zones other than UTC and GMT are rejected, see parseTimeWith.
+var defaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC1123Z,
+	time.RFC1123,
+	"2006-01-02 15:04:05.999999999",
+}
+
+// timeLayouts are the layouts Time currently accepts.
+var timeLayouts = defaultTimeLayouts
+
+// SetTimeLayouts sets the layouts Time.UnmarshalJSON, Time.UnmarshalText and Time.Scan try in order,
+// succeeding with the first one that matches. Calling it with no layouts restores the default:
+// RFC 3339 with optional fractional seconds, RFC 1123 with a numeric zone, UTC or GMT,
+// and "2006-01-02 15:04:05" with optional fractional seconds.
+// It is not safe to call while values are being decoded, unless SetConfig has been called:
+// then it replaces the Config with a copy holding the new layouts, and is safe to call concurrently with SetConfig.
+func SetTimeLayouts(layouts []string) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	if c, _ := config.Load().(*Config); c != nil {
+		cfg := *c
+		cfg.TimeLayouts = layouts
+		storeConfig(cfg)
+		return
+	}
+	if len(layouts) == 0 {
+		timeLayouts = defaultTimeLayouts
+		return
+	}
+	timeLayouts = append([]string(nil), layouts...)
+}
+
+// timestampScanLayouts are the layouts Timestamp.Scan accepts for string input, as returned by
+// drivers such as MySQL's for DATETIME columns. Times without an offset are taken to be UTC.
+var timestampScanLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-0700",
+	"2006-01-02 15:04:05.999999999",
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+}
+
+// parseTimeLayouts parses str with the first of the configured time layouts that matches.
+func parseTimeLayouts(str string) (time.Time, error) {
+	layouts := currentTimeLayouts()
+	if len(layouts) == 0 {
+		layouts = defaultTimeLayouts
+	}
+	return parseTimeWith(layouts, str)
+}
+
+// parseTimeWith parses str with the first of layouts that matches.
+// time.Parse gives zone abbreviations it doesn't know, such as "PST" outside of US Pacific time,
+// an offset of zero, which would silently shift the time, so they count as not matching.
+func parseTimeWith(layouts []string, str string) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, str); err == nil && !unknownZone(t) {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("time %q matches none of the layouts %q", str, layouts)
+}
+
+// unknownZone returns true if t has a named zone with an offset of zero that isn't UTC, GMT or from the local zone,
+// which is what time.Parse makes of abbreviations it can't resolve.
+func unknownZone(t time.Time) bool {
+	name, offset := t.Zone()
+	return offset == 0 && name != "" && name != "UTC" && name != "GMT" && t.Location() != time.Local
+}
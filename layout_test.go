@@ -0,0 +1,97 @@
+package null
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestTimeLayouts(t *testing.T) {
+	want := time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+	for _, in := range []string{
+		"2012-12-21T21:21:21Z",
+		"2012-12-21T21:21:21.000Z",
+		"Fri, 21 Dec 2012 21:21:21 +0000",
+		"Fri, 21 Dec 2012 21:21:21 UTC",
+		"Fri, 21 Dec 2012 21:21:21 GMT",
+		"2012-12-21 21:21:21",
+	} {
+		var fromJSON Time
+		err := json.Unmarshal([]byte(strconv.Quote(in)), &fromJSON)
+		maybePanic(err)
+		if !fromJSON.Valid || !fromJSON.Time.Equal(want) {
+			t.Errorf("bad JSON %q: %v", in, fromJSON.Time)
+		}
+
+		var fromText Time
+		err = fromText.UnmarshalText([]byte(in))
+		maybePanic(err)
+		if !fromText.Valid || !fromText.Time.Equal(want) {
+			t.Errorf("bad text %q: %v", in, fromText.Time)
+		}
+	}
+
+	var micro Time
+	err := micro.UnmarshalText([]byte("2012-12-21 21:21:21.123456"))
+	maybePanic(err)
+	if micro.Time.Nanosecond() != 123456000 {
+		t.Errorf("lost fractional seconds: %v", micro.Time)
+	}
+
+	var bad Time
+	if err := json.Unmarshal([]byte(`"21/12/2012"`), &bad); err == nil {
+		t.Error("expected error for unsupported layout")
+	}
+	// PST can't be resolved outside of US Pacific time and would parse as +0000
+	if zone, _ := want.In(time.Local).Zone(); zone != "PST" {
+		if err := bad.UnmarshalText([]byte("Fri, 21 Dec 2012 21:21:21 PST")); err == nil {
+			t.Errorf("expected error for unknown zone, got %v", bad.Time)
+		}
+	}
+	if err := bad.UnmarshalText([]byte("21/12/2012")); err == nil {
+		t.Error("expected error for unsupported layout")
+	}
+}
+
+func TestSetTimeLayouts(t *testing.T) {
+	defer SetTimeLayouts(nil)
+
+	SetTimeLayouts([]string{"02/01/2006"})
+	var ti Time
+	err := json.Unmarshal([]byte(`"21/12/2012"`), &ti)
+	maybePanic(err)
+	if want := time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC); !ti.Time.Equal(want) {
+		t.Errorf("bad custom layout: %v", ti.Time)
+	}
+	if err := json.Unmarshal(timeJSON, &ti); err == nil {
+		t.Error("expected error for RFC 3339 after replacing the layouts")
+	}
+
+	SetTimeLayouts(nil)
+	err = json.Unmarshal(timeJSON, &ti)
+	maybePanic(err)
+	assertTime(t, ti, "default layouts restored")
+}
+
+func TestSetTimeLayoutsConcurrentSetConfig(t *testing.T) {
+	defer resetConfig()
+	SetConfig(DefaultConfig())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			SetTimeLayouts([]string{time.RFC3339})
+		}
+	}()
+	cfg := GetConfig()
+	cfg.BoolMarshalAsInt = true
+	SetConfig(cfg)
+	<-done
+
+	// SetTimeLayouts must not store a copy of the Config from before SetConfig.
+	if got := GetConfig(); !got.BoolMarshalAsInt {
+		t.Errorf("concurrent SetConfig lost: %#v", got)
+	}
+}
@@ -0,0 +1,83 @@
+package null
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FloatLocale makes Float parse string input, from JSON strings and text, with localized separators
+// instead of Go syntax. Create it with FloatWithLocale. The zero Locale, the default, disables it.
+// JSON numbers are not affected, as they always use Go syntax.
+//
+// Deprecated: set Config.FloatLocale with SetConfig, which is safe to call while values are decoded.
+// This variable is ignored once SetConfig has been called.
+var FloatLocale Locale
+
+// Locale holds the separators of localized number strings, such as "1.234,56".
+type Locale struct {
+	Decimal   rune
+	Thousands rune
+}
+
+// FloatWithLocale returns a Locale to set FloatLocale to.
+// Both separators must be given explicitly, as "1,234" means different numbers in different locales.
+// It returns an error if they are the same, or if either is missing, a digit or a sign.
+func FloatWithLocale(decimal, thousands rune) (Locale, error) {
+	for _, r := range []rune{decimal, thousands} {
+		if r == 0 || r == utf8.RuneError || r == '+' || r == '-' || (r >= '0' && r <= '9') {
+			return Locale{}, fmt.Errorf("null: invalid separator %q", r)
+		}
+	}
+	if decimal == thousands {
+		return Locale{}, errors.New("null: decimal and thousands separators must differ")
+	}
+	return Locale{Decimal: decimal, Thousands: thousands}, nil
+}
+
+// parseFloat parses str as a float64 using FloatLocale, or Go syntax if it isn't set.
+func parseFloat(str string) (float64, error) {
+	locale := floatLocale()
+	if locale == (Locale{}) {
+		return strconv.ParseFloat(str, 64)
+	}
+	normalized, err := locale.normalize(str)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(normalized, 64)
+}
+
+// normalize converts a localized number to Go syntax.
+// Thousands separators must group the integer digits by three, and anything else, such as a second
+// decimal separator or a separator of another locale, is rejected as ambiguous.
+func (l Locale) normalize(str string) (string, error) {
+	invalid := fmt.Errorf("invalid number %q for decimal separator %q and thousands separator %q", str, l.Decimal, l.Thousands)
+
+	var sign string
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		sign, str = str[:1], str[1:]
+	}
+	parts := strings.Split(str, string(l.Decimal))
+	if len(parts) > 2 || (len(parts) == 2 && !isDigits(parts[1])) {
+		return "", invalid
+	}
+
+	groups := strings.Split(parts[0], string(l.Thousands))
+	if len(groups) > 1 && (len(groups[0]) == 0 || len(groups[0]) > 3) {
+		return "", invalid
+	}
+	for i, g := range groups {
+		if !isDigits(g) || (i > 0 && len(g) != 3) {
+			return "", invalid
+		}
+	}
+
+	normalized := sign + strings.Join(groups, "")
+	if len(parts) == 2 {
+		normalized += "." + parts[1]
+	}
+	return normalized, nil
+}
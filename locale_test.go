@@ -0,0 +1,64 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFloatLocale(t *testing.T) {
+	defer func(prev Locale) { FloatLocale = prev }(FloatLocale)
+
+	var err error
+	FloatLocale, err = FloatWithLocale(',', '.')
+	maybePanic(err)
+
+	tests := []struct {
+		in   string
+		want float64
+	}{
+		{`"19,99"`, 19.99},
+		{`"1.234,56"`, 1234.56},
+		{`"-1.234.567"`, -1234567},
+		{`"1,234"`, 1.234},
+		{`"12"`, 12},
+		{`19.99`, 19.99},
+	}
+	for _, test := range tests {
+		var f Float
+		err := json.Unmarshal([]byte(test.in), &f)
+		maybePanic(err)
+		if !f.Valid || f.Float64 != test.want {
+			t.Errorf("bad %s: %v ≠ %v", test.in, f.Float64, test.want)
+		}
+	}
+
+	for _, bad := range []string{`"19.99"`, `"1.23,4,5"`, `"12.34"`, `"1234.567"`, `".123"`, `"1,"`, `"1e3"`, `"1,2,3"`} {
+		var f Float
+		if err := json.Unmarshal([]byte(bad), &f); err == nil {
+			t.Errorf("expected error for %s, got %v", bad, f.Float64)
+		}
+	}
+
+	var f Float
+	err = f.UnmarshalText([]byte("1.234,56"))
+	maybePanic(err)
+	if f.Float64 != 1234.56 {
+		t.Errorf("bad text: %v", f.Float64)
+	}
+
+	FloatLocale = Locale{}
+	if err := json.Unmarshal([]byte(`"19,99"`), &f); err == nil {
+		t.Error("expected error for comma decimal by default")
+	}
+}
+
+func TestFloatWithLocaleInvalid(t *testing.T) {
+	for _, seps := range [][2]rune{{',', ','}, {0, '.'}, {',', 0}, {'1', '.'}, {',', '-'}} {
+		if _, err := FloatWithLocale(seps[0], seps[1]); err == nil {
+			t.Errorf("expected error for %q", seps)
+		}
+	}
+	if _, err := FloatWithLocale('.', ' '); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package null
+
+import (
+	"encoding/json"
+)
+
+// Marshal is like json.Marshal, but leaves out object keys whose value is null,
+// so null fields are absent from the output instead of encoded as null.
+// This also applies to nested objects and to fields that aren't types from this package,
+// such as nil pointers, maps, and slices. Null array elements are kept.
+// Key order is otherwise unchanged.
+//
+// This costs a second pass over the encoded output, so prefer json.Marshal
+// where null fields are fine.
+func Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	out, _ := dropNullKeys(make([]byte, 0, len(data)), data, 0)
+	return out, nil
+}
+
+// dropNullKeys copies the JSON value starting at data[i] to dst, leaving out object members with a null value.
+// data must be valid compact JSON, as produced by json.Marshal.
+// It returns the extended dst and the index just past the value.
+func dropNullKeys(dst, data []byte, i int) ([]byte, int) {
+	switch data[i] {
+	case '{':
+		dst = append(dst, '{')
+		i++
+		first := true
+		for data[i] != '}' {
+			keyStart := i
+			i = skipString(data, i)
+			key := data[keyStart:i]
+			i++ // ':'
+			if isNullAt(data, i) {
+				i += len(nullBytes)
+			} else {
+				if !first {
+					dst = append(dst, ',')
+				}
+				first = false
+				dst = append(dst, key...)
+				dst = append(dst, ':')
+				dst, i = dropNullKeys(dst, data, i)
+			}
+			if data[i] == ',' {
+				i++
+			}
+		}
+		return append(dst, '}'), i + 1
+	case '[':
+		dst = append(dst, '[')
+		i++
+		for data[i] != ']' {
+			dst, i = dropNullKeys(dst, data, i)
+			if data[i] == ',' {
+				dst = append(dst, ',')
+				i++
+			}
+		}
+		return append(dst, ']'), i + 1
+	case '"':
+		end := skipString(data, i)
+		return append(dst, data[i:end]...), end
+	}
+	end := i
+	for end < len(data) && data[end] != ',' && data[end] != '}' && data[end] != ']' {
+		end++
+	}
+	return append(dst, data[i:end]...), end
+}
+
+// skipString returns the index just past the JSON string starting at data[i].
+func skipString(data []byte, i int) int {
+	for i++; data[i] != '"'; i++ {
+		if data[i] == '\\' {
+			i++
+		}
+	}
+	return i + 1
+}
+
+// isNullAt reports whether the JSON value starting at data[i] is null.
+func isNullAt(data []byte, i int) bool {
+	return len(data)-i >= len(nullBytes) && string(data[i:i+len(nullBytes)]) == "null"
+}
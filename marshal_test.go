@@ -0,0 +1,61 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type marshalRecord struct {
+	Name    String            `json:"name"`
+	Age     Int               `json:"age"`
+	Score   Float             `json:"score"`
+	Note    *string           `json:"note"`
+	Tags    []String          `json:"tags"`
+	Extra   map[string]String `json:"extra"`
+	Nested  *marshalRecord    `json:"nested,omitempty"`
+	Escaped String            `json:"escaped"`
+}
+
+func TestMarshalDropsNullKeys(t *testing.T) {
+	rec := marshalRecord{
+		Name:    StringFrom("alice"),
+		Age:     NewInt(0, false),
+		Score:   FloatFrom(0),
+		Tags:    []String{StringFrom("a"), NewString("", false)},
+		Extra:   map[string]String{"gone": NewString("", false), "kept": StringFrom("x")},
+		Nested:  &marshalRecord{Age: IntFrom(3)},
+		Escaped: StringFrom(`quote " and comma, null}`),
+	}
+	data, err := Marshal(rec)
+	maybePanic(err)
+	want := `{"name":"alice","score":0,"tags":["a",null],"extra":{"kept":"x"},` +
+		`"nested":{"age":3},"escaped":"quote \" and comma, null}"}`
+	assertJSONEquals(t, data, want, "Marshal")
+
+	var back marshalRecord
+	err = json.Unmarshal(data, &back)
+	maybePanic(err)
+	if back.Age.Valid || !back.Name.Equal(rec.Name) || !back.Score.Equal(rec.Score) {
+		t.Errorf("bad round trip: %#v", back)
+	}
+}
+
+func TestMarshalAllNull(t *testing.T) {
+	data, err := Marshal(marshalRecord{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "{}", "Marshal all null")
+
+	data, err = Marshal(NewString("", false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "Marshal null value")
+
+	data, err = Marshal([]Int{IntFrom(1), NewInt(0, false)})
+	maybePanic(err)
+	assertJSONEquals(t, data, "[1,null]", "Marshal array")
+}
+
+func TestMarshalError(t *testing.T) {
+	if _, err := Marshal(make(chan int)); err == nil {
+		t.Error("expected error")
+	}
+}
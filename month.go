@@ -0,0 +1,231 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MonthMarshalAsName makes Month marshal to its English name, such as "January",
+// instead of its number. Unmarshaling accepts both forms regardless.
+//
+// Deprecated: set Config.MonthMarshalAsName with SetConfig, which is safe to call while values are decoded.
+// This variable is ignored once SetConfig has been called.
+var MonthMarshalAsName = false
+
+// Month is a nullable time.Month. It is stored in SQL as its number, 1 to 12.
+// It will marshal to null if null.
+type Month struct {
+	Month time.Month
+	Valid bool
+}
+
+// NewMonth creates a new Month
+func NewMonth(m time.Month, valid bool) Month {
+	return Month{Month: m, Valid: valid}
+}
+
+// MonthFrom creates a new Month that will always be valid.
+func MonthFrom(m time.Month) Month {
+	return NewMonth(m, true)
+}
+
+// MonthFromPtr creates a new Month that will be null if m is nil.
+func MonthFromPtr(m *time.Month) Month {
+	if m == nil {
+		return NewMonth(0, false)
+	}
+	return NewMonth(*m, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (m Month) ValueOrZero() time.Month {
+	if !m.Valid {
+		return 0
+	}
+	return m.Month
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports integers from 1 to 12 and nil input.
+func (m *Month) Scan(value interface{}) error {
+	return reportError(m.scan(value))
+}
+
+func (m *Month) scan(value interface{}) error {
+	i, err := scanInt(value)
+	if err != nil {
+		*m = Month{}
+		return newScanError("Month", value, err)
+	}
+	if !i.Valid {
+		*m = Month{}
+		return nil
+	}
+	return m.setNumber(i.Int64)
+}
+
+// Value implements the driver Valuer interface.
+// It stores the month number.
+func (m Month) Value() (driver.Value, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+	return int64(m.Month), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, month name, and null input.
+func (m *Month) UnmarshalJSON(data []byte) error {
+	return reportError(m.unmarshalJSON(data))
+}
+
+func (m *Month) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*m = Month{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		return m.setName(str)
+	}
+	var n int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		*m = Month{}
+		return newUnmarshalError("Month", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	return m.setNumber(n)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports a number or a month name, and will unmarshal to a null Month if the input is blank or "null".
+func (m *Month) UnmarshalText(text []byte) error {
+	return reportError(m.unmarshalText(text))
+}
+
+func (m *Month) unmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		*m = Month{}
+		return nil
+	}
+	if n, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return m.setNumber(n)
+	}
+	return m.setName(str)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Month is null,
+// and the month name instead of its number if MonthMarshalAsName is set.
+func (m Month) MarshalJSON() ([]byte, error) {
+	if !m.Valid {
+		return []byte("null"), nil
+	}
+	if monthMarshalAsName() {
+		return []byte(`"` + m.Month.String() + `"`), nil
+	}
+	return []byte(strconv.Itoa(int(m.Month))), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Month is null,
+// and the month name instead of its number if MonthMarshalAsName is set.
+func (m Month) MarshalText() ([]byte, error) {
+	if !m.Valid {
+		return []byte{}, nil
+	}
+	if monthMarshalAsName() {
+		return []byte(m.Month.String()), nil
+	}
+	return []byte(strconv.Itoa(int(m.Month))), nil
+}
+
+// SetValid changes this Month's value and also sets it to be non-null.
+func (m *Month) SetValid(v time.Month) {
+	m.Month = v
+	m.Valid = true
+}
+
+// Ptr returns a pointer to this Month's value, or a nil pointer if this Month is null.
+func (m Month) Ptr() *time.Month {
+	if !m.Valid {
+		return nil
+	}
+	return &m.Month
+}
+
+// Reset makes this Month null and clears its value, so a reused Month doesn't keep previous data.
+func (m *Month) Reset() {
+	*m = Month{}
+}
+
+// IsZero returns true for null Months.
+func (m Month) IsZero() bool {
+	return !m.Valid
+}
+
+// AsAny returns nil if this Month is null, otherwise its value as time.Month.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (m Month) AsAny() interface{} {
+	if !m.Valid {
+		return nil
+	}
+	return m.Month
+}
+
+// Equal returns true if both months are the same or are both null.
+func (m Month) Equal(other Month) bool {
+	return m.Valid == other.Valid && (!m.Valid || m.Month == other.Month)
+}
+
+// setNumber stores n if it is a month number, otherwise it makes m null and returns an error.
+func (m *Month) setNumber(n int64) error {
+	if n < int64(time.January) || n > int64(time.December) {
+		*m = Month{}
+		return newUnmarshalError("Month", fmt.Errorf("month %d is out of range [1, 12]", n))
+	}
+	m.SetValid(time.Month(n))
+	return nil
+}
+
+// setName stores the month named name, ignoring case, otherwise it makes m null and returns an error.
+func (m *Month) setName(name string) error {
+	for v := time.January; v <= time.December; v++ {
+		if strings.EqualFold(name, v.String()) {
+			m.SetValid(v)
+			return nil
+		}
+	}
+	*m = Month{}
+	return newUnmarshalError("Month", fmt.Errorf("invalid month %q", name))
+}
+
+// JSONSchema returns the JSON Schema of this Month's JSON encoding: a month number from 1 to 12, or its name if MonthMarshalAsName is set, or null.
+func (m Month) JSONSchema() map[string]interface{} {
+	if monthMarshalAsName() {
+		names := make([]interface{}, 12)
+		for i := range names {
+			names[i] = time.Month(i + 1).String()
+		}
+		return nullableEnumSchema("string", names)
+	}
+	schema := nullableSchema("integer")
+	schema["minimum"] = 1
+	schema["maximum"] = 12
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Month as the Go code that creates it.
+func (m Month) GoString() string {
+	if !m.Valid {
+		return `null.NewMonth(0, false)`
+	}
+	return "null.MonthFrom(time." + m.Month.String() + ")"
+}
@@ -0,0 +1,118 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestMonthScan(t *testing.T) {
+	for _, n := range []int64{1, 6, 12} {
+		var m Month
+		err := m.Scan(n)
+		maybePanic(err)
+		if !m.Equal(MonthFrom(time.Month(n))) {
+			t.Errorf("bad scan of %d: %#v", n, m)
+		}
+		v, err := m.Value()
+		maybePanic(err)
+		if v != n {
+			t.Errorf("bad value of %d: %#v", n, v)
+		}
+	}
+
+	for _, n := range []int64{0, 13, -1} {
+		m := MonthFrom(time.March)
+		if err := m.Scan(n); err == nil {
+			t.Errorf("expected error for month %d", n)
+		}
+		if m.Valid {
+			t.Errorf("month %d should be invalid", n)
+		}
+	}
+
+	var null Month
+	err := null.Scan(nil)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("scanned nil should be invalid")
+	}
+}
+
+func TestMonthUnmarshalJSON(t *testing.T) {
+	for _, in := range []string{`1`, `"January"`, `"january"`} {
+		var m Month
+		err := json.Unmarshal([]byte(in), &m)
+		maybePanic(err)
+		if !m.Equal(MonthFrom(time.January)) {
+			t.Errorf("bad unmarshal of %s: %#v", in, m)
+		}
+	}
+
+	var dec Month
+	err := json.Unmarshal([]byte(`12`), &dec)
+	maybePanic(err)
+	if !dec.Equal(MonthFrom(time.December)) {
+		t.Errorf("bad unmarshal of 12: %#v", dec)
+	}
+
+	var null Month
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should be invalid")
+	}
+
+	for _, bad := range []string{`0`, `13`, `1.5`, `"Smarch"`, `true`} {
+		m := MonthFrom(time.May)
+		if err := json.Unmarshal([]byte(bad), &m); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+		if m.Valid {
+			t.Errorf("%s should be invalid", bad)
+		}
+	}
+}
+
+func TestMonthMarshal(t *testing.T) {
+	defer func(prev bool) { MonthMarshalAsName = prev }(MonthMarshalAsName)
+
+	m := MonthFrom(time.February)
+	data, err := json.Marshal(m)
+	maybePanic(err)
+	assertJSONEquals(t, data, "2", "month json marshal")
+	data, err = m.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "2", "month text marshal")
+
+	MonthMarshalAsName = true
+	data, err = json.Marshal(m)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"February"`, "month name json marshal")
+	data, err = m.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "February", "month name text marshal")
+
+	data, err = json.Marshal(NewMonth(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null month json marshal")
+}
+
+func TestMonthUnmarshalText(t *testing.T) {
+	var m Month
+	err := m.UnmarshalText([]byte("11"))
+	maybePanic(err)
+	if !m.Equal(MonthFrom(time.November)) {
+		t.Errorf("bad unmarshal of 11: %#v", m)
+	}
+
+	err = m.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if m.Valid {
+		t.Error("blank text should be invalid")
+	}
+
+	if err := m.UnmarshalText([]byte("0")); err == nil {
+		t.Error("expected error for month 0")
+	}
+}
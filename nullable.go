@@ -0,0 +1,12 @@
+package null
+
+import (
+	"database/sql/driver"
+)
+
+// Nullable is implemented by all types in this package.
+type Nullable interface {
+	driver.Valuer
+	// IsZero returns true if the value is null.
+	IsZero() bool
+}
@@ -0,0 +1,19 @@
+module github.com/zero-pkg/null/nullschema
+
+go 1.18
+
+require (
+	github.com/invopop/jsonschema v0.12.0
+	github.com/zero-pkg/null v0.0.0
+)
+
+require (
+	github.com/bahlo/generic-list-go v0.2.0 // indirect
+	github.com/buger/jsonparser v1.1.1 // indirect
+	github.com/mailru/easyjson v0.7.7 // indirect
+	github.com/wk8/go-ordered-map/v2 v2.1.8 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/zero-pkg/null => ../
@@ -0,0 +1,86 @@
+// Package nullschema maps the types of package null to the schemas of github.com/invopop/jsonschema.
+// It is a separate module, so invopop/jsonschema isn't a dependency of package null.
+package nullschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/invopop/jsonschema"
+
+	"github.com/zero-pkg/null"
+)
+
+// nullPkgPath is the import path of package null.
+var nullPkgPath = reflect.TypeOf(null.String{}).PkgPath()
+
+// schemer is implemented by every type of package null.
+type schemer interface {
+	JSONSchema() map[string]interface{}
+}
+
+// Mapper returns the schema of t's JSON encoding if t is a type of package null, or nil otherwise.
+// Set it as the Mapper of a jsonschema.Reflector, which would otherwise describe the types' struct fields:
+//
+//	r := jsonschema.Reflector{Mapper: nullschema.Mapper}
+//	schema := r.Reflect(&Person{})
+func Mapper(t reflect.Type) *jsonschema.Schema {
+	if t.PkgPath() != nullPkgPath {
+		return nil
+	}
+	s, ok := reflect.Zero(t).Interface().(schemer)
+	if !ok {
+		return nil
+	}
+	return convert(s.JSONSchema())
+}
+
+// convert turns a schema returned by a JSONSchema method into a jsonschema.Schema.
+// jsonschema.Schema only holds a single type, so a list of types, such as ["string", "null"],
+// goes in its Extras, which it marshals in place of the type.
+func convert(m map[string]interface{}) *jsonschema.Schema {
+	rest := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		rest[k] = v
+	}
+	types, multi := m["type"].([]string)
+	if multi {
+		delete(rest, "type")
+	}
+	items, _ := m["items"].(map[string]interface{})
+	delete(rest, "items")
+	props, _ := m["properties"].(map[string]interface{})
+	delete(rest, "properties")
+
+	s := new(jsonschema.Schema)
+	data, err := json.Marshal(rest)
+	if err == nil {
+		err = json.Unmarshal(data, s)
+	}
+	if err != nil {
+		// the schemas of package null only hold JSON Schema keywords that jsonschema.Schema supports
+		panic(fmt.Sprintf("nullschema: couldn't convert schema %v: %v", m, err))
+	}
+
+	if multi {
+		s.Extras = map[string]interface{}{"type": types}
+	}
+	if items != nil {
+		s.Items = convert(items)
+	}
+	if props != nil {
+		names := make([]string, 0, len(props))
+		for name := range props {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		s.Properties = jsonschema.NewProperties()
+		for _, name := range names {
+			prop, _ := props[name].(map[string]interface{})
+			s.Properties.Set(name, convert(prop))
+		}
+	}
+	return s
+}
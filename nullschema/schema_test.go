@@ -0,0 +1,75 @@
+package nullschema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/invopop/jsonschema"
+
+	"github.com/zero-pkg/null"
+)
+
+func TestMapper(t *testing.T) {
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{"String", null.String{}, `{"type":["string","null"]}`},
+		{"Int", null.Int{}, `{"type":["integer","null"]}`},
+		{"Time", null.Time{}, `{"format":"date-time","type":["string","null"]}`},
+		{"Percent", null.Percent{}, `{"maximum":100,"minimum":0,"type":["number","null"]}`},
+		{"Ints", null.Ints{}, `{"items":{"type":["integer","null"]},"type":["array","null"]}`},
+		{"Tagged", null.Tagged[null.Bool]{}, `{"additionalProperties":false,` +
+			`"properties":{"valid":{"type":"boolean"},"value":{"type":["boolean","null"]}},` +
+			`"required":["value","valid"],"type":["object","null"]}`},
+	}
+	for _, test := range tests {
+		schema := Mapper(reflect.TypeOf(test.v))
+		if schema == nil {
+			t.Errorf("%s: no schema", test.name)
+			continue
+		}
+		assertSchema(t, test.name, schema, test.want)
+	}
+
+	if schema := Mapper(reflect.TypeOf("")); schema != nil {
+		t.Errorf("string: unexpected schema %v", schema)
+	}
+}
+
+func TestReflector(t *testing.T) {
+	type person struct {
+		Name null.String `json:"name"`
+		Age  null.Int    `json:"age"`
+	}
+	r := jsonschema.Reflector{Mapper: Mapper, DoNotReference: true, Anonymous: true}
+	schema := r.Reflect(&person{})
+	name, ok := schema.Properties.Get("name")
+	if !ok {
+		t.Fatal("missing name property")
+	}
+	assertSchema(t, "name", name, `{"type":["string","null"]}`)
+	age, _ := schema.Properties.Get("age")
+	assertSchema(t, "age", age, `{"type":["integer","null"]}`)
+}
+
+// assertSchema checks that schema marshals to the same JSON as want.
+func assertSchema(t *testing.T, name string, schema *jsonschema.Schema, want string) {
+	t.Helper()
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	var got, exp interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	if err := json.Unmarshal([]byte(want), &exp); err != nil {
+		t.Fatalf("%s: bad want: %v", name, err)
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf("%s: schema = %s, want %s", name, data, want)
+	}
+}
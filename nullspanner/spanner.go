@@ -0,0 +1,39 @@
+// Package nullspanner converts the null types of the Cloud Spanner client to the types of package null.
+// It is a separate module, so the Spanner client isn't a dependency of package null.
+package nullspanner
+
+import (
+	"cloud.google.com/go/spanner"
+
+	"github.com/zero-pkg/null"
+)
+
+// StringFromSpanner creates a new null.String from a spanner.NullString.
+func StringFromSpanner(s spanner.NullString) null.String {
+	return null.NewString(s.StringVal, s.Valid)
+}
+
+// IntFromSpanner creates a new null.Int from a spanner.NullInt64.
+func IntFromSpanner(i spanner.NullInt64) null.Int {
+	return null.NewInt(i.Int64, i.Valid)
+}
+
+// FloatFromSpanner creates a new null.Float from a spanner.NullFloat64.
+func FloatFromSpanner(f spanner.NullFloat64) null.Float {
+	return null.NewFloat(f.Float64, f.Valid)
+}
+
+// BoolFromSpanner creates a new null.Bool from a spanner.NullBool.
+func BoolFromSpanner(b spanner.NullBool) null.Bool {
+	return null.NewBool(b.Bool, b.Valid)
+}
+
+// TimeFromSpanner creates a new null.Time from a spanner.NullTime.
+func TimeFromSpanner(t spanner.NullTime) null.Time {
+	return null.NewTime(t.Time, t.Valid)
+}
+
+// TimestampFromSpanner creates a new null.Timestamp from a spanner.NullTime.
+func TimestampFromSpanner(t spanner.NullTime) null.Timestamp {
+	return null.NewTimestamp(t.Time, t.Valid)
+}
@@ -0,0 +1,66 @@
+package nullspanner
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/zero-pkg/null"
+)
+
+func TestStringFromSpanner(t *testing.T) {
+	if got := StringFromSpanner(spanner.NullString{StringVal: "test", Valid: true}); !got.Equal(null.StringFrom("test")) {
+		t.Errorf("StringFromSpanner() = %#v", got)
+	}
+	if got := StringFromSpanner(spanner.NullString{}); got.Valid {
+		t.Errorf("StringFromSpanner() null = %#v", got)
+	}
+}
+
+func TestIntFromSpanner(t *testing.T) {
+	if got := IntFromSpanner(spanner.NullInt64{Int64: 12345, Valid: true}); !got.Equal(null.IntFrom(12345)) {
+		t.Errorf("IntFromSpanner() = %#v", got)
+	}
+	if got := IntFromSpanner(spanner.NullInt64{}); got.Valid {
+		t.Errorf("IntFromSpanner() null = %#v", got)
+	}
+}
+
+func TestFloatFromSpanner(t *testing.T) {
+	if got := FloatFromSpanner(spanner.NullFloat64{Float64: 1.2345, Valid: true}); !got.Equal(null.FloatFrom(1.2345)) {
+		t.Errorf("FloatFromSpanner() = %#v", got)
+	}
+	if got := FloatFromSpanner(spanner.NullFloat64{}); got.Valid {
+		t.Errorf("FloatFromSpanner() null = %#v", got)
+	}
+}
+
+func TestBoolFromSpanner(t *testing.T) {
+	if got := BoolFromSpanner(spanner.NullBool{Bool: true, Valid: true}); !got.Equal(null.BoolFrom(true)) {
+		t.Errorf("BoolFromSpanner() = %#v", got)
+	}
+	if got := BoolFromSpanner(spanner.NullBool{}); got.Valid {
+		t.Errorf("BoolFromSpanner() null = %#v", got)
+	}
+}
+
+func TestTimeFromSpanner(t *testing.T) {
+	when := time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+	if got := TimeFromSpanner(spanner.NullTime{Time: when, Valid: true}); !got.Equal(null.TimeFrom(when)) {
+		t.Errorf("TimeFromSpanner() = %#v", got)
+	}
+	if got := TimeFromSpanner(spanner.NullTime{}); got.Valid {
+		t.Errorf("TimeFromSpanner() null = %#v", got)
+	}
+}
+
+func TestTimestampFromSpanner(t *testing.T) {
+	when := time.Unix(1356124881, 0)
+	if got := TimestampFromSpanner(spanner.NullTime{Time: when, Valid: true}); !got.Valid || !got.Time.Equal(when) {
+		t.Errorf("TimestampFromSpanner() = %#v", got)
+	}
+	if got := TimestampFromSpanner(spanner.NullTime{}); got.Valid {
+		t.Errorf("TimestampFromSpanner() null = %#v", got)
+	}
+}
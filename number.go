@@ -0,0 +1,321 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Number is a nullable json.Number.
+// It keeps a number in its original textual form, so integers and floats keep their shape.
+// It will decode to null, not zero, if null.
+type Number struct {
+	Number json.Number
+	Valid  bool
+}
+
+// NewNumber creates a new Number
+func NewNumber(n json.Number, valid bool) Number {
+	return Number{
+		Number: n,
+		Valid:  valid,
+	}
+}
+
+// NumberFrom creates a new Number that will always be valid.
+func NumberFrom(n json.Number) Number {
+	return NewNumber(n, true)
+}
+
+// NumberFromPtr creates a new Number that will be null if n is nil.
+func NumberFromPtr(n *json.Number) Number {
+	if n == nil {
+		return NewNumber("", false)
+	}
+	return NewNumber(*n, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise a blank json.Number.
+func (n Number) ValueOrZero() json.Number {
+	if !n.Valid {
+		return ""
+	}
+	return n.Number
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (n Number) ValueOr(def json.Number) json.Number {
+	if !n.Valid {
+		return def
+	}
+	return n.Number
+}
+
+// Int64 returns the number as an int64.
+// It returns false if this Number is null or not an integer that fits in an int64.
+func (n Number) Int64() (int64, bool) {
+	if !n.Valid {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(string(n.Number), 10, 64)
+	return v, err == nil
+}
+
+// Float64 returns the number as a float64.
+// It returns false if this Number is null or out of range for a float64.
+func (n Number) Float64() (float64, bool) {
+	if !n.Valid {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(string(n.Number), 64)
+	return v, err == nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports int64, float64, []byte, string and nil input.
+func (n *Number) Scan(value interface{}) error {
+	return reportError(n.scan(value))
+}
+
+func (n *Number) scan(value interface{}) error {
+	var err error
+	switch v := scanSource(value).(type) {
+	case nil:
+		n.Number, n.Valid = "", false
+		return nil
+	case int64:
+		n.Number = json.Number(strconv.FormatInt(v, 10))
+	case float64:
+		n.Number, err = formatFloatNumber(v)
+	case []byte:
+		n.Number, err = parseNumber(string(v))
+	case string:
+		n.Number, err = parseNumber(v)
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		n.Valid = false
+		return newScanError("Number", value, err)
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Integers are passed to the driver as int64, all other numbers as float64.
+func (n Number) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	if v, ok := n.Int64(); ok {
+		return v, nil
+	}
+	v, err := strconv.ParseFloat(string(n.Number), 64)
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't convert Number to float: %w", err)
+	}
+	return v, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// The number is stored exactly as given.
+func (n *Number) UnmarshalJSON(data []byte) error {
+	return reportError(n.unmarshalJSON(data))
+}
+
+func (n *Number) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		n.Valid = false
+		return nil
+	}
+
+	str := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &str); err != nil {
+			n.Valid = false
+			return newUnmarshalError("Number", fmt.Errorf("couldn't unmarshal number string: %w", err))
+		}
+	}
+	num, err := parseNumber(str)
+	if err != nil {
+		n.Valid = false
+		return newUnmarshalError("Number", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	n.Number = num
+	n.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Number if the input is blank.
+// It will return an error if the input is not a number, blank, or "null".
+func (n *Number) UnmarshalText(text []byte) error {
+	return reportError(n.unmarshalText(text))
+}
+
+func (n *Number) unmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		n.Valid = false
+		return nil
+	}
+	num, err := parseNumber(str)
+	if err != nil {
+		n.Valid = false
+		return newUnmarshalError("Number", fmt.Errorf("couldn't unmarshal text: %w", err))
+	}
+	n.Number = num
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Number is null.
+func (n Number) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(n.Number), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Number is null.
+func (n Number) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return []byte(n.Number), nil
+}
+
+// SetValid changes this Number's value and also sets it to be non-null.
+func (n *Number) SetValid(v json.Number) {
+	n.Number = v
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this Number's value, or a nil pointer if this Number is null.
+func (n Number) Ptr() *json.Number {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Number
+}
+
+// Reset makes this Number null and clears its value, so a reused Number doesn't keep previous data.
+func (n *Number) Reset() {
+	*n = Number{}
+}
+
+// IsZero returns true for invalid Numbers.
+// A non-null Number with a 0 value will not be considered zero.
+func (n Number) IsZero() bool {
+	return !n.Valid
+}
+
+// AsAny returns nil if this Number is null, otherwise its value as json.Number.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (n Number) AsAny() interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.Number
+}
+
+// Equal returns true if both numbers are written the same way or are both null.
+// Numbers are compared as text, so 42 and 42.0 are not Equal.
+func (n Number) Equal(other Number) bool {
+	return n.Valid == other.Valid && (!n.Valid || n.Number == other.Number)
+}
+
+// ValueEqual returns true if both numbers are written the same way, treating null as a blank json.Number.
+// Unlike Equal, a null Number is ValueEqual to a valid blank one.
+func (n Number) ValueEqual(other Number) bool {
+	return n.ValueOrZero() == other.ValueOrZero()
+}
+
+// formatFloatNumber formats f so that it still reads as a float, i.e. 42 becomes 42.0.
+func formatFloatNumber(f float64) (json.Number, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", fmt.Errorf("unsupported float value: %v", f)
+	}
+	str := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(str, ".e") {
+		str += ".0"
+	}
+	return json.Number(str), nil
+}
+
+// parseNumber returns str as a json.Number, or an error if str is not a valid JSON number.
+func parseNumber(str string) (json.Number, error) {
+	if !isValidNumber(str) {
+		return "", errors.New("invalid number " + strconv.Quote(str))
+	}
+	return json.Number(str), nil
+}
+
+// isValidNumber reports whether s is a valid JSON number literal.
+func isValidNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	if s[0] == '-' {
+		s = s[1:]
+	}
+	s, ok := skipInteger(s)
+	if !ok {
+		return false
+	}
+	if s != "" && s[0] == '.' {
+		if s, ok = skipDigits(s[1:]); !ok {
+			return false
+		}
+	}
+	if s != "" && (s[0] == 'e' || s[0] == 'E') {
+		s = s[1:]
+		if s != "" && (s[0] == '+' || s[0] == '-') {
+			s = s[1:]
+		}
+		if s, ok = skipDigits(s); !ok {
+			return false
+		}
+	}
+	return s == ""
+}
+
+// skipInteger skips the integer part of a JSON number, which can't have leading zeros.
+func skipInteger(s string) (string, bool) {
+	if s != "" && s[0] == '0' {
+		return s[1:], true
+	}
+	return skipDigits(s)
+}
+
+// skipDigits skips one or more digits.
+func skipDigits(s string) (string, bool) {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[i:], i > 0
+}
+
+// JSONSchema returns the JSON Schema of this Number's JSON encoding: a number or null.
+func (n Number) JSONSchema() map[string]interface{} {
+	return nullableSchema("number")
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Number as the Go code that creates it.
+func (n Number) GoString() string {
+	if !n.Valid {
+		return `null.NewNumber("", false)`
+	}
+	return "null.NumberFrom(" + strconv.Quote(string(n.Number)) + ")"
+}
@@ -0,0 +1,271 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var (
+	numberIntJSON   = []byte(`42`)
+	numberFloatJSON = []byte(`42.0`)
+)
+
+func TestNumberFrom(t *testing.T) {
+	n := NumberFrom("42")
+	assertNumber(t, n, "42", "NumberFrom()")
+
+	num := json.Number("42")
+	n = NumberFromPtr(&num)
+	assertNumber(t, n, "42", "NumberFromPtr()")
+
+	null := NumberFromPtr(nil)
+	assertNullNumber(t, null, "NumberFromPtr(nil)")
+}
+
+func TestUnmarshalNumber(t *testing.T) {
+	var i Number
+	err := json.Unmarshal(numberIntJSON, &i)
+	maybePanic(err)
+	assertNumber(t, i, "42", "int json")
+
+	var f Number
+	err = json.Unmarshal(numberFloatJSON, &f)
+	maybePanic(err)
+	assertNumber(t, f, "42.0", "float json")
+
+	var str Number
+	err = json.Unmarshal([]byte(`"1e3"`), &str)
+	maybePanic(err)
+	assertNumber(t, str, "1e3", "string json")
+
+	var null Number
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullNumber(t, null, "null json")
+
+	var badType Number
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullNumber(t, badType, "wrong type json")
+
+	var badString Number
+	err = json.Unmarshal([]byte(`"abc"`), &badString)
+	if err == nil {
+		t.Error("expected error")
+	}
+	assertNullNumber(t, badString, "non-number string json")
+
+	var invalid Number
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var unmarshalErr *UnmarshalError
+	if !errors.As(err, &unmarshalErr) || unmarshalErr.Type != "Number" {
+		t.Errorf("expected UnmarshalError for Number, not %v", err)
+	}
+	assertNullNumber(t, invalid, "invalid json")
+}
+
+func TestNumberRoundTrip(t *testing.T) {
+	for _, input := range []string{"42", "42.0", "-0.5", "1e3", "12345678901234567890"} {
+		var n Number
+		err := json.Unmarshal([]byte(input), &n)
+		maybePanic(err)
+		data, err := json.Marshal(n)
+		maybePanic(err)
+		assertJSONEquals(t, data, input, "round trip")
+	}
+}
+
+func TestMarshalNumber(t *testing.T) {
+	data, err := json.Marshal(NewNumber("42", false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = NumberFrom("42").MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "42", "text marshal")
+
+	data, err = NewNumber("42", false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestTextUnmarshalNumber(t *testing.T) {
+	var n Number
+	err := n.UnmarshalText([]byte("1.5"))
+	maybePanic(err)
+	assertNumber(t, n, "1.5", "UnmarshalText()")
+
+	var blank Number
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullNumber(t, blank, "UnmarshalText() blank")
+
+	var null Number
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullNumber(t, null, "UnmarshalText() null")
+
+	var invalid Number
+	for _, bad := range []string{"abc", "01", "1.", ".5", "1e", "--1", "+1", "0x10", "NaN"} {
+		if err := invalid.UnmarshalText([]byte(bad)); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestNumberAccessors(t *testing.T) {
+	i := NumberFrom("42")
+	if v, ok := i.Int64(); !ok || v != 42 {
+		t.Errorf("Int64() = %d, %t, want 42, true", v, ok)
+	}
+	if v, ok := i.Float64(); !ok || v != 42 {
+		t.Errorf("Float64() = %f, %t, want 42, true", v, ok)
+	}
+
+	f := NumberFrom("1.5")
+	if _, ok := f.Int64(); ok {
+		t.Error("Int64() of a float should fail")
+	}
+	if v, ok := f.Float64(); !ok || v != 1.5 {
+		t.Errorf("Float64() = %f, %t, want 1.5, true", v, ok)
+	}
+
+	null := NewNumber("42", false)
+	if _, ok := null.Int64(); ok {
+		t.Error("Int64() of null should fail")
+	}
+	if _, ok := null.Float64(); ok {
+		t.Error("Float64() of null should fail")
+	}
+}
+
+func TestNumberScanValue(t *testing.T) {
+	var i Number
+	err := i.Scan(int64(42))
+	maybePanic(err)
+	assertNumber(t, i, "42", "scanned int64")
+	if v, err := i.Value(); v != int64(42) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var f Number
+	err = f.Scan(float64(42))
+	maybePanic(err)
+	assertNumber(t, f, "42.0", "scanned float64")
+	if v, err := f.Value(); v != float64(42) || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var b Number
+	err = b.Scan([]byte("3.14"))
+	maybePanic(err)
+	assertNumber(t, b, "3.14", "scanned []byte")
+
+	var null Number
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullNumber(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var wrong Number
+	if err := wrong.Scan("abc"); err == nil {
+		t.Error("expected error")
+	}
+	if err := wrong.Scan(true); err == nil {
+		t.Error("expected error")
+	}
+	assertNullNumber(t, wrong, "bad scan")
+}
+
+func TestNumberPointer(t *testing.T) {
+	n := NumberFrom("42")
+	if ptr := n.Ptr(); ptr == nil || *ptr != "42" {
+		t.Errorf("bad pointer: %#v", ptr)
+	}
+	null := NewNumber("", false)
+	if ptr := null.Ptr(); ptr != nil {
+		t.Errorf("bad nil pointer: %#v", ptr)
+	}
+}
+
+func TestNumberIsZeroSetValid(t *testing.T) {
+	n := NewNumber("", false)
+	if !n.IsZero() {
+		t.Error("IsZero() should be true")
+	}
+	n.SetValid("0")
+	assertNumber(t, n, "0", "SetValid()")
+	if n.IsZero() {
+		t.Error("IsZero() should be false")
+	}
+	if n.ValueOrZero() != "0" || NewNumber("1", false).ValueOrZero() != "" {
+		t.Error("unexpected ValueOrZero")
+	}
+}
+
+func TestNumberEqual(t *testing.T) {
+	if !NewNumber("1", false).Equal(NewNumber("2", false)) {
+		t.Error("null Numbers should be Equal")
+	}
+	if !NumberFrom("42").Equal(NumberFrom("42")) {
+		t.Error("same Numbers should be Equal")
+	}
+	if NumberFrom("42").Equal(NumberFrom("42.0")) {
+		t.Error("42 and 42.0 should not be Equal")
+	}
+	if NumberFrom("42").Equal(NewNumber("42", false)) {
+		t.Error("valid and null Numbers should not be Equal")
+	}
+}
+
+func TestNumberValueOr(t *testing.T) {
+	valid := NewNumber("42", true)
+	if valid.ValueOr("0.5") != "42" {
+		t.Error("unexpected ValueOr", valid.ValueOr("0.5"))
+	}
+
+	invalid := NewNumber("42", false)
+	if invalid.ValueOr("0.5") != "0.5" {
+		t.Error("unexpected ValueOr", invalid.ValueOr("0.5"))
+	}
+}
+
+func TestNumberValueEqual(t *testing.T) {
+	null := NewNumber("42", false)
+	zero := NewNumber("", true)
+	valid := NewNumber("42", true)
+	if !null.ValueEqual(zero) || !zero.ValueEqual(null) {
+		t.Error("ValueEqual() of null and valid zero should be true")
+	}
+	if null.Equal(zero) {
+		t.Error("Equal() of null and valid zero should be false")
+	}
+	if !null.ValueEqual(null) || !valid.ValueEqual(valid) {
+		t.Error("ValueEqual() of the same values should be true")
+	}
+	if valid.ValueEqual(zero) || null.ValueEqual(valid) {
+		t.Error("ValueEqual() of different values should be false")
+	}
+}
+
+func assertNumber(t *testing.T, n Number, want json.Number, from string) {
+	t.Helper()
+	if n.Number != want {
+		t.Errorf("bad %s number: %s ≠ %s\n", from, n.Number, want)
+	}
+	if !n.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullNumber(t *testing.T, n Number, from string) {
+	t.Helper()
+	if n.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
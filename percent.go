@@ -0,0 +1,205 @@
+package null
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+)
+
+// Bounds and tolerance used by Percent.
+var (
+	// PercentMin is the smallest value Percent accepts on input.
+	//
+	// Deprecated: set Config.PercentMin with SetConfig, which is safe to call while values are decoded.
+	// This variable is ignored once SetConfig has been called.
+	PercentMin = 0.0
+	// PercentMax is the largest value Percent accepts on input.
+	//
+	// Deprecated: set Config.PercentMax with SetConfig, which is safe to call while values are decoded.
+	// This variable is ignored once SetConfig has been called.
+	PercentMax = 100.0
+	// PercentEpsilon is the largest difference for which two Percents are still Equal.
+	//
+	// Deprecated: set Config.PercentEpsilon with SetConfig, which is safe to call while values are decoded.
+	// This variable is ignored once SetConfig has been called.
+	PercentEpsilon = 1e-9
+)
+
+// Percent is a nullable float64 percentage.
+// Unmarshaling and scanning reject values outside [PercentMin, PercentMax].
+// It will decode to null, not zero, if null.
+type Percent struct {
+	sql.NullFloat64
+}
+
+// NewPercent creates a new Percent
+func NewPercent(f float64, valid bool) Percent {
+	return Percent{
+		NullFloat64: sql.NullFloat64{
+			Float64: f,
+			Valid:   valid,
+		},
+	}
+}
+
+// PercentFrom creates a new Percent that will always be valid.
+// f is not checked against the bounds.
+func PercentFrom(f float64) Percent {
+	return NewPercent(f, true)
+}
+
+// PercentFromPtr creates a new Percent that will be null if f is nil.
+func PercentFromPtr(f *float64) Percent {
+	if f == nil {
+		return NewPercent(0, false)
+	}
+	return NewPercent(*f, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (p Percent) ValueOrZero() float64 {
+	if !p.Valid {
+		return 0
+	}
+	return p.Float64
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (p Percent) ValueOr(def float64) float64 {
+	if !p.Valid {
+		return def
+	}
+	return p.Float64
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if the value is out of bounds.
+func (p *Percent) Scan(value interface{}) error {
+	return reportError(p.scan(value))
+}
+
+func (p *Percent) scan(value interface{}) error {
+	var f Float
+	if err := f.NullFloat64.Scan(scanSource(value)); err != nil {
+		p.Valid = false
+		return newScanError("Percent", value, err)
+	}
+	return p.set(f, nil)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports the same input as Float and returns an error if the value is out of bounds.
+func (p *Percent) UnmarshalJSON(data []byte) error {
+	return reportError(p.unmarshalJSON(data))
+}
+
+func (p *Percent) unmarshalJSON(data []byte) error {
+	var f Float
+	err := f.unmarshalJSON(data)
+	return p.set(f, err)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports the same input as Float and returns an error if the value is out of bounds.
+func (p *Percent) UnmarshalText(text []byte) error {
+	return reportError(p.unmarshalText(text))
+}
+
+func (p *Percent) unmarshalText(text []byte) error {
+	var f Float
+	err := f.unmarshalText(text)
+	return p.set(f, err)
+}
+
+// set stores f if err is nil and f is in bounds, otherwise it makes p null and returns the error.
+func (p *Percent) set(f Float, err error) error {
+	lo, hi := percentBounds()
+	if err == nil && f.Valid && (f.Float64 < lo || f.Float64 > hi) {
+		err = fmt.Errorf("%v is out of range [%v, %v]", f.Float64, lo, hi)
+	}
+	if err != nil {
+		p.Valid = false
+		return retypeError("Percent", err)
+	}
+	p.NullFloat64 = f.NullFloat64
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Percent is null.
+func (p Percent) MarshalJSON() ([]byte, error) {
+	return Float{NullFloat64: p.NullFloat64}.MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Percent is null.
+func (p Percent) MarshalText() ([]byte, error) {
+	return Float{NullFloat64: p.NullFloat64}.MarshalText()
+}
+
+// SetValid changes this Percent's value and also sets it to be non-null.
+func (p *Percent) SetValid(n float64) {
+	p.Float64 = n
+	p.Valid = true
+}
+
+// Ptr returns a pointer to this Percent's value, or a nil pointer if this Percent is null.
+func (p Percent) Ptr() *float64 {
+	if !p.Valid {
+		return nil
+	}
+	return &p.Float64
+}
+
+// Unwrap returns this Percent as a sql.NullFloat64, for code that needs the standard library type.
+func (p Percent) Unwrap() sql.NullFloat64 {
+	return p.NullFloat64
+}
+
+// Reset makes this Percent null and clears its value, so a reused Percent doesn't keep previous data.
+func (p *Percent) Reset() {
+	*p = Percent{}
+}
+
+// IsZero returns true for invalid Percents.
+// A non-null Percent with a 0 value will not be considered zero.
+func (p Percent) IsZero() bool {
+	return !p.Valid
+}
+
+// AsAny returns nil if this Percent is null, otherwise its value as float64.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (p Percent) AsAny() interface{} {
+	if !p.Valid {
+		return nil
+	}
+	return p.Float64
+}
+
+// Equal returns true if both percentages differ by at most PercentEpsilon or are both null.
+func (p Percent) Equal(other Percent) bool {
+	return p.Valid == other.Valid && (!p.Valid || math.Abs(p.Float64-other.Float64) <= percentEpsilon())
+}
+
+// ValueEqual returns true if both percentages differ by at most PercentEpsilon, treating null as zero.
+// Unlike Equal, a null Percent is ValueEqual to a valid zero.
+func (p Percent) ValueEqual(other Percent) bool {
+	return math.Abs(p.ValueOrZero()-other.ValueOrZero()) <= percentEpsilon()
+}
+
+// JSONSchema returns the JSON Schema of this Percent's JSON encoding: a number between PercentMin and PercentMax, or null.
+func (p Percent) JSONSchema() map[string]interface{} {
+	lo, hi := percentBounds()
+	schema := nullableSchema("number")
+	schema["minimum"] = lo
+	schema["maximum"] = hi
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Percent as the Go code that creates it.
+func (p Percent) GoString() string {
+	if !p.Valid {
+		return `null.NewPercent(0, false)`
+	}
+	return "null.PercentFrom(" + goFloat(p.Float64) + ")"
+}
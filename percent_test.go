@@ -0,0 +1,196 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestPercentFrom(t *testing.T) {
+	assertPercent(t, PercentFrom(12.5), 12.5, "PercentFrom()")
+
+	f := 12.5
+	assertPercent(t, PercentFromPtr(&f), 12.5, "PercentFromPtr()")
+	assertNullPercent(t, PercentFromPtr(nil), "PercentFromPtr(nil)")
+}
+
+func TestUnmarshalPercent(t *testing.T) {
+	for _, input := range []string{"0", "12.5", "100", `"42"`} {
+		var p Percent
+		err := json.Unmarshal([]byte(input), &p)
+		maybePanic(err)
+		if !p.Valid {
+			t.Errorf("%s should be valid", input)
+		}
+	}
+
+	var null Percent
+	err := json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullPercent(t, null, "null json")
+
+	for _, input := range []string{"-0.1", "100.5", `"1000"`, "true"} {
+		p := PercentFrom(50)
+		err := json.Unmarshal([]byte(input), &p)
+		var unmarshalErr *UnmarshalError
+		if !errors.As(err, &unmarshalErr) || unmarshalErr.Type != "Percent" {
+			t.Errorf("expected UnmarshalError for Percent from %s, not %v", input, err)
+		}
+		assertNullPercent(t, p, input)
+	}
+}
+
+func TestPercentDecodersKeepValue(t *testing.T) {
+	// f must be read after it is decoded, not copied into set's arguments before
+	decoders := map[string]func(p *Percent) error{
+		"UnmarshalJSON": func(p *Percent) error { return p.UnmarshalJSON([]byte("42.5")) },
+		"UnmarshalText": func(p *Percent) error { return p.UnmarshalText([]byte("42.5")) },
+		"Scan":          func(p *Percent) error { return p.Scan(42.5) },
+	}
+	for name, decode := range decoders {
+		p := PercentFrom(7)
+		err := decode(&p)
+		maybePanic(err)
+		assertPercent(t, p, 42.5, name)
+	}
+}
+
+func TestPercentBounds(t *testing.T) {
+	defer func(min, max float64) { PercentMin, PercentMax = min, max }(PercentMin, PercentMax)
+	PercentMin, PercentMax = 0, 1
+
+	var p Percent
+	err := p.UnmarshalText([]byte("0.5"))
+	maybePanic(err)
+	assertPercent(t, p, 0.5, "UnmarshalText() in custom bounds")
+
+	if err := p.UnmarshalText([]byte("50")); err == nil {
+		t.Error("expected error for value outside custom bounds")
+	}
+	assertNullPercent(t, p, "UnmarshalText() outside custom bounds")
+}
+
+func TestTextUnmarshalPercent(t *testing.T) {
+	var p Percent
+	err := p.UnmarshalText([]byte("99.9"))
+	maybePanic(err)
+	assertPercent(t, p, 99.9, "UnmarshalText()")
+
+	var blank Percent
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullPercent(t, blank, "UnmarshalText() blank")
+
+	var invalid Percent
+	if err := invalid.UnmarshalText([]byte("abc")); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalPercent(t *testing.T) {
+	data, err := json.Marshal(PercentFrom(12.5))
+	maybePanic(err)
+	assertJSONEquals(t, data, "12.5", "json marshal")
+
+	data, err = json.Marshal(NewPercent(12.5, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = PercentFrom(12.5).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12.5", "text marshal")
+}
+
+func TestPercentScanValue(t *testing.T) {
+	var p Percent
+	err := p.Scan(12.5)
+	maybePanic(err)
+	assertPercent(t, p, 12.5, "scanned float")
+	if v, err := p.Value(); v != 12.5 || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+
+	var null Percent
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullPercent(t, null, "scanned null")
+
+	var out Percent
+	if err := out.Scan(float64(101)); err == nil {
+		t.Error("expected error")
+	}
+	assertNullPercent(t, out, "scanned out of range")
+}
+
+func TestPercentHelpers(t *testing.T) {
+	p := NewPercent(0, false)
+	if !p.IsZero() || p.Ptr() != nil || p.ValueOrZero() != 0 {
+		t.Error("unexpected null Percent helpers")
+	}
+	p.SetValid(10)
+	if p.IsZero() || *p.Ptr() != 10 || p.ValueOrZero() != 10 {
+		t.Error("unexpected valid Percent helpers")
+	}
+}
+
+func TestPercentEqual(t *testing.T) {
+	if !PercentFrom(0.1 + 0.2).Equal(PercentFrom(0.3)) {
+		t.Error("Percents within epsilon should be Equal")
+	}
+	if PercentFrom(0.3).Equal(PercentFrom(0.31)) {
+		t.Error("different Percents should not be Equal")
+	}
+	if !NewPercent(1, false).Equal(NewPercent(2, false)) {
+		t.Error("null Percents should be Equal")
+	}
+	if PercentFrom(1).Equal(NewPercent(1, false)) {
+		t.Error("valid and null Percents should not be Equal")
+	}
+}
+
+func TestPercentValueOr(t *testing.T) {
+	valid := NewPercent(12.5, true)
+	if valid.ValueOr(50) != 12.5 {
+		t.Error("unexpected ValueOr", valid.ValueOr(50))
+	}
+
+	invalid := NewPercent(12.5, false)
+	if invalid.ValueOr(50) != 50 {
+		t.Error("unexpected ValueOr", invalid.ValueOr(50))
+	}
+}
+
+func TestPercentValueEqual(t *testing.T) {
+	null := NewPercent(12.5, false)
+	zero := NewPercent(0, true)
+	valid := NewPercent(12.5, true)
+	if !null.ValueEqual(zero) || !zero.ValueEqual(null) {
+		t.Error("ValueEqual() of null and valid zero should be true")
+	}
+	if null.Equal(zero) {
+		t.Error("Equal() of null and valid zero should be false")
+	}
+	if !null.ValueEqual(null) || !valid.ValueEqual(valid) {
+		t.Error("ValueEqual() of the same values should be true")
+	}
+	if valid.ValueEqual(zero) || null.ValueEqual(valid) {
+		t.Error("ValueEqual() of different values should be false")
+	}
+}
+
+func assertPercent(t *testing.T, p Percent, want float64, from string) {
+	t.Helper()
+	if p.Float64 != want {
+		t.Errorf("bad %s percent: %v ≠ %v\n", from, p.Float64, want)
+	}
+	if !p.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullPercent(t *testing.T, p Percent, from string) {
+	t.Helper()
+	if p.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
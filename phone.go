@@ -0,0 +1,204 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Phone is a nullable phone number in E.164 format, such as +14155552671.
+// Input is normalized by removing spaces, dashes, dots and parentheses,
+// and must then be a + followed by 2 to 15 digits, the first of which isn't 0.
+// It will marshal to null if null.
+type Phone struct {
+	Number string
+	Valid  bool
+}
+
+// PhoneFrom normalizes s and returns a valid Phone.
+// It returns an error and a null Phone if s is not a valid E.164 number.
+func PhoneFrom(s string) (Phone, error) {
+	p, err := parsePhone(s)
+	if err != nil {
+		return Phone{}, newUnmarshalError("Phone", err)
+	}
+	return p, nil
+}
+
+// MustPhone is like PhoneFrom but panics if s is not a valid E.164 number.
+// It simplifies initializing fixtures and package-level variables, like regexp.MustCompile.
+func MustPhone(s string) Phone {
+	p, err := PhoneFrom(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the normalized number, or a blank string if this Phone is null.
+func (p Phone) String() string {
+	if !p.Valid {
+		return ""
+	}
+	return p.Number
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil input, and normalizes the number.
+func (p *Phone) Scan(value interface{}) error {
+	return reportError(p.scan(value))
+}
+
+func (p *Phone) scan(value interface{}) error {
+	var err error
+	switch x := scanSource(value).(type) {
+	case nil:
+		*p = Phone{}
+		return nil
+	case string:
+		*p, err = parsePhone(x)
+	case []byte:
+		*p, err = parsePhone(string(x))
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		*p = Phone{}
+		return newScanError("Phone", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It stores the normalized number.
+func (p Phone) Value() (driver.Value, error) {
+	if !p.Valid {
+		return nil, nil
+	}
+	return p.Number, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (p *Phone) UnmarshalJSON(data []byte) error {
+	return reportError(p.unmarshalJSON(data))
+}
+
+func (p *Phone) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*p = Phone{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		*p = Phone{}
+		return newUnmarshalError("Phone", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	parsed, err := parsePhone(str)
+	if err != nil {
+		*p = Phone{}
+		return newUnmarshalError("Phone", err)
+	}
+	*p = parsed
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Phone if the input is blank.
+func (p *Phone) UnmarshalText(text []byte) error {
+	return reportError(p.unmarshalText(text))
+}
+
+func (p *Phone) unmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*p = Phone{}
+		return nil
+	}
+	parsed, err := parsePhone(string(text))
+	if err != nil {
+		*p = Phone{}
+		return newUnmarshalError("Phone", err)
+	}
+	*p = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Phone is null.
+func (p Phone) MarshalJSON() ([]byte, error) {
+	if !p.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(p.Number)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Phone is null.
+func (p Phone) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// Reset makes this Phone null and clears its value, so a reused Phone doesn't keep previous data.
+func (p *Phone) Reset() {
+	*p = Phone{}
+}
+
+// IsZero returns true for null Phones.
+func (p Phone) IsZero() bool {
+	return !p.Valid
+}
+
+// AsAny returns nil if this Phone is null, otherwise the normalized number.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (p Phone) AsAny() interface{} {
+	if !p.Valid {
+		return nil
+	}
+	return p.Number
+}
+
+// Equal returns true if both Phones have the same normalized number or are both null.
+func (p Phone) Equal(other Phone) bool {
+	return p.Valid == other.Valid && (!p.Valid || p.Number == other.Number)
+}
+
+// JSONSchema returns the JSON Schema of this Phone's JSON encoding: an E.164 phone number string or null.
+func (p Phone) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("string")
+	schema["pattern"] = `^\+[1-9][0-9]{1,14}$`
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Phone as the Go code that creates it.
+func (p Phone) GoString() string {
+	if !p.Valid {
+		return "null.Phone{}"
+	}
+	return "null.Phone{Number: " + strconv.Quote(p.Number) + ", Valid: true}"
+}
+
+// parsePhone normalizes and validates an E.164 phone number.
+func parsePhone(s string) (Phone, error) {
+	number := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, s)
+	digits := strings.TrimPrefix(number, "+")
+	if len(digits) == len(number) || len(digits) < 2 || len(digits) > 15 || digits[0] == '0' {
+		return Phone{}, fmt.Errorf("invalid phone number %q: need + and 2 to 15 digits, not starting with 0", s)
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Phone{}, fmt.Errorf("invalid phone number %q: unexpected %q", s, c)
+		}
+	}
+	return Phone{Number: number, Valid: true}, nil
+}
@@ -0,0 +1,100 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+)
+
+func TestPhoneNormalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"+14155552671", "+14155552671"},
+		{"+1 415 555 2671", "+14155552671"},
+		{"+1-415-555-2671", "+14155552671"},
+		{"+1 (415) 555.2671", "+14155552671"},
+		{"+44 20 7946 0958", "+442079460958"},
+	}
+	for _, test := range tests {
+		var p Phone
+		err := json.Unmarshal([]byte(`"`+test.in+`"`), &p)
+		maybePanic(err)
+		if !p.Valid || p.Number != test.want {
+			t.Errorf("bad unmarshal of %q: %#v", test.in, p)
+		}
+
+		data, err := json.Marshal(p)
+		maybePanic(err)
+		assertJSONEquals(t, data, `"`+test.want+`"`, "phone json marshal")
+
+		var scanned Phone
+		err = scanned.Scan([]byte(test.in))
+		maybePanic(err)
+		if !scanned.Equal(p) {
+			t.Errorf("bad scan of %q: %#v", test.in, scanned)
+		}
+		v, err := scanned.Value()
+		maybePanic(err)
+		if v != test.want {
+			t.Errorf("bad value: %#v", v)
+		}
+
+		str := test.in
+		for _, src := range []interface{}{sql.RawBytes(test.in), &str, sql.NullString{String: test.in, Valid: true}} {
+			var other Phone
+			err = other.Scan(src)
+			maybePanic(err)
+			if !other.Equal(p) {
+				t.Errorf("bad scan of %#v: %#v", src, other)
+			}
+		}
+	}
+}
+
+func TestPhoneInvalid(t *testing.T) {
+	for _, in := range []string{"4155552671", "+", "+1", "+0123456", "+1415555267100000", "+1 415 CALL NOW", "++14155552671"} {
+		p := MustPhone("+14155552671")
+		if err := p.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+		if p.Valid {
+			t.Errorf("%q should be invalid", in)
+		}
+		if _, err := PhoneFrom(in); err == nil {
+			t.Errorf("expected error from PhoneFrom(%q)", in)
+		}
+		if err := p.Scan(in); err == nil {
+			t.Errorf("expected error scanning %q", in)
+		}
+	}
+	var p Phone
+	if err := json.Unmarshal([]byte(`14155552671`), &p); err == nil {
+		t.Error("expected error for number json")
+	}
+	assertPanics(t, func() { MustPhone("555") }, "MustPhone(555)")
+}
+
+func TestPhoneNull(t *testing.T) {
+	p := MustPhone("+14155552671")
+	err := json.Unmarshal(nullJSON, &p)
+	maybePanic(err)
+	if p.Valid {
+		t.Error("null json should be invalid")
+	}
+	data, err := json.Marshal(p)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null phone json marshal")
+
+	err = p.UnmarshalText(nil)
+	maybePanic(err)
+	if !p.Equal(Phone{}) {
+		t.Errorf("blank text should be null: %#v", p)
+	}
+	v, err := p.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null value should be nil, not %#v", v)
+	}
+}
@@ -0,0 +1,33 @@
+package null
+
+import (
+	"encoding/json"
+)
+
+// Provided wraps a value of any type, held in Val, and records whether it was decoded with UnmarshalJSON, even from null.
+// encoding/json only calls UnmarshalJSON for keys present in the input, so WasProvided tells
+// a JSON key set to null apart from an absent key. It marshals to JSON the same as Val.
+// The flag lives in the wrapper, so the values in this package still compare with ==.
+type Provided[T any] struct {
+	Val T
+
+	provided bool
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It decodes data into Val, and marks this Provided as provided even if that fails.
+func (p *Provided[T]) UnmarshalJSON(data []byte) error {
+	p.provided = true
+	return json.Unmarshal(data, &p.Val)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It encodes Val.
+func (p Provided[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Val)
+}
+
+// WasProvided returns true if this Provided was decoded with UnmarshalJSON, even from null.
+func (p Provided[T]) WasProvided() bool {
+	return p.provided
+}
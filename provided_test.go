@@ -0,0 +1,56 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestProvided(t *testing.T) {
+	type record struct {
+		S  Provided[String]
+		I  Provided[Int]
+		F  Provided[Float]
+		B  Provided[Bool]
+		T  Provided[Time]
+		TS Provided[Timestamp]
+	}
+	provided := func(r record) []bool {
+		return []bool{r.S.WasProvided(), r.I.WasProvided(), r.F.WasProvided(), r.B.WasProvided(), r.T.WasProvided(), r.TS.WasProvided()}
+	}
+
+	var null record
+	err := json.Unmarshal([]byte(`{"S":null,"I":null,"F":null,"B":null,"T":null,"TS":null}`), &null)
+	maybePanic(err)
+	for i, ok := range provided(null) {
+		if !ok {
+			t.Errorf("field %d: present null should be provided", i)
+		}
+	}
+	assertNullStr(t, null.S.Val, "present null")
+
+	var valid record
+	err = json.Unmarshal([]byte(`{"S":"test","I":12345,"F":1.2345,"B":true,"T":"2012-12-21T21:21:21Z","TS":1356124881}`), &valid)
+	maybePanic(err)
+	for i, ok := range provided(valid) {
+		if !ok {
+			t.Errorf("field %d: present value should be provided", i)
+		}
+	}
+	assertStr(t, valid.S.Val, "present value")
+	if valid.S.Val != StringFrom("test") {
+		t.Error("decoded value should compare == to a constructed one")
+	}
+
+	var absent record
+	err = json.Unmarshal([]byte(`{}`), &absent)
+	maybePanic(err)
+	for i, ok := range append(provided(absent), provided(record{S: Provided[String]{Val: StringFrom("test")}})...) {
+		if ok {
+			t.Errorf("field %d: value never decoded should not be provided", i)
+		}
+	}
+
+	data, err := json.Marshal(valid.S)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"test"`, "provided json marshal")
+}
@@ -0,0 +1,226 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Rat is a nullable exact fraction, such as a gear ratio of 3/4, backed by a *big.Rat.
+// Its value is always reduced, so 2/4 is stored as 1/2.
+// It is stored and marshaled as text, such as "3/4", or null if null.
+//
+// Methods of Rat never modify the big.Rat it points to, and values passed in or returned are copies,
+// so a Rat doesn't share state with its inputs. Copies of a Rat share the pointer, so don't modify
+// the big.Rat of one through its Rat field. A Rat with a nil Rat field is treated as null, even if Valid is set.
+type Rat struct {
+	Rat   *big.Rat
+	Valid bool
+}
+
+// RatFrom creates a new Rat holding a copy of r.
+// It will be null if r is nil.
+func RatFrom(r *big.Rat) Rat {
+	if r == nil {
+		return Rat{}
+	}
+	return Rat{Rat: new(big.Rat).Set(r), Valid: true}
+}
+
+// MustRat parses a fraction written as a/b, or an integer, and panics if s isn't one.
+// It simplifies initializing fixtures and package-level variables, like regexp.MustCompile.
+func MustRat(s string) Rat {
+	r, err := parseRat(s)
+	if err != nil {
+		panic(newUnmarshalError("Rat", err))
+	}
+	return r
+}
+
+// String returns the fraction as a/b, or as an integer if the denominator is 1.
+// It returns a blank string if this Rat is null.
+func (r Rat) String() string {
+	if !r.ok() {
+		return ""
+	}
+	return r.Rat.RatString()
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports []byte, string, int64 and nil input. Text must be a fraction written as a/b, or an integer.
+func (r *Rat) Scan(value interface{}) error {
+	return reportError(r.scan(value))
+}
+
+func (r *Rat) scan(value interface{}) error {
+	var err error
+	switch v := scanSource(value).(type) {
+	case nil:
+		*r = Rat{}
+		return nil
+	case int64:
+		*r = Rat{Rat: new(big.Rat).SetInt64(v), Valid: true}
+	case []byte:
+		*r, err = parseRat(string(v))
+	case string:
+		*r, err = parseRat(v)
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		*r = Rat{}
+		return newScanError("Rat", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It passes the fraction to the driver as a string.
+func (r Rat) Value() (driver.Value, error) {
+	if !r.ok() {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input. The string must be a fraction written as a/b, or an integer.
+func (r *Rat) UnmarshalJSON(data []byte) error {
+	return reportError(r.unmarshalJSON(data))
+}
+
+func (r *Rat) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*r = Rat{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		*r = Rat{}
+		return newUnmarshalError("Rat", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	parsed, err := parseRat(str)
+	if err != nil {
+		*r = Rat{}
+		return newUnmarshalError("Rat", err)
+	}
+	*r = parsed
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Rat if the input is blank.
+func (r *Rat) UnmarshalText(text []byte) error {
+	return reportError(r.unmarshalText(text))
+}
+
+func (r *Rat) unmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*r = Rat{}
+		return nil
+	}
+	parsed, err := parseRat(string(text))
+	if err != nil {
+		*r = Rat{}
+		return newUnmarshalError("Rat", err)
+	}
+	*r = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Rat is null, otherwise a string such as "3/4".
+func (r Rat) MarshalJSON() ([]byte, error) {
+	if !r.ok() {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.Quote(r.String())), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Rat is null.
+func (r Rat) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// Reset makes this Rat null and clears its value, so a reused Rat doesn't keep previous data.
+func (r *Rat) Reset() {
+	*r = Rat{}
+}
+
+// IsZero returns true for null Rats.
+// A non-null Rat with a 0 value will not be considered zero.
+func (r Rat) IsZero() bool {
+	return !r.ok()
+}
+
+// AsAny returns nil if this Rat is null, otherwise a copy of its value as *big.Rat.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (r Rat) AsAny() interface{} {
+	if !r.ok() {
+		return nil
+	}
+	return r.Ptr()
+}
+
+// Ptr returns a copy of this Rat's value, or nil if this Rat is null.
+// Modifying the result doesn't change the Rat.
+func (r Rat) Ptr() *big.Rat {
+	if !r.ok() {
+		return nil
+	}
+	return new(big.Rat).Set(r.Rat)
+}
+
+// Equal returns true if both Rats have the same value or are both null.
+func (r Rat) Equal(other Rat) bool {
+	if !r.ok() || !other.ok() {
+		return r.ok() == other.ok()
+	}
+	return r.Rat.Cmp(other.Rat) == 0
+}
+
+// ok returns true if this Rat is valid and has a value.
+func (r Rat) ok() bool {
+	return r.Valid && r.Rat != nil
+}
+
+// JSONSchema returns the JSON Schema of this Rat's JSON encoding: a fraction string such as "3/4", or null.
+func (r Rat) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("string")
+	schema["pattern"] = "^-?[0-9]+(/[0-9]+)?$"
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Rat as the Go code that creates it.
+func (r Rat) GoString() string {
+	if !r.ok() {
+		return "null.Rat{}"
+	}
+	return "null.MustRat(" + strconv.Quote(r.String()) + ")"
+}
+
+// parseRat parses a fraction written as a/b, or an integer, into a new big.Rat.
+// Decimal and exponent notation are rejected, so a huge exponent can't make parsing slow.
+func parseRat(s string) (Rat, error) {
+	num, den := s, "1"
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		num, den = s[:i], s[i+1:]
+	}
+	if !isDigits(strings.TrimPrefix(num, "-")) || !isDigits(den) {
+		return Rat{}, errors.New("invalid fraction " + strconv.Quote(s))
+	}
+	v, ok := new(big.Rat).SetString(num + "/" + den)
+	if !ok {
+		// the denominator is 0
+		return Rat{}, errors.New("invalid fraction " + strconv.Quote(s))
+	}
+	return Rat{Rat: v, Valid: true}, nil
+}
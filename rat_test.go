@@ -0,0 +1,138 @@
+package null
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestRatJSON(t *testing.T) {
+	var r Rat
+	err := json.Unmarshal([]byte(`"2/4"`), &r)
+	maybePanic(err)
+	if !r.Equal(MustRat("1/2")) {
+		t.Errorf("2/4 should equal 1/2: %#v", r)
+	}
+	data, err := json.Marshal(r)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"1/2"`, "rat json marshal")
+
+	err = json.Unmarshal([]byte(`"-6"`), &r)
+	maybePanic(err)
+	data, err = json.Marshal(r)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"-6"`, "integer rat json marshal")
+
+	var null Rat
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, `null`, "null rat json marshal")
+
+	for _, bad := range []string{`0.75`, `"1/0"`, `"0.75"`, `"1e3"`, `"1/-2"`, `"+1/2"`, `"/2"`, `"1/"`, `"1/2/3"`, `""`} {
+		r := MustRat("1")
+		if err := json.Unmarshal([]byte(bad), &r); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+		if r.Valid {
+			t.Errorf("%s should be null", bad)
+		}
+	}
+}
+
+func TestRatScanValue(t *testing.T) {
+	tests := []struct {
+		in   interface{}
+		want string
+	}{
+		{[]byte("3/4"), "3/4"},
+		{"-10/4", "-5/2"},
+		{"8/4", "2"},
+		{int64(42), "42"},
+	}
+	for _, test := range tests {
+		var r Rat
+		err := r.Scan(test.in)
+		maybePanic(err)
+		v, err := r.Value()
+		maybePanic(err)
+		if v != test.want {
+			t.Errorf("Scan(%#v).Value() = %#v, want %s", test.in, v, test.want)
+		}
+	}
+
+	var r Rat
+	err := r.Scan(nil)
+	maybePanic(err)
+	if v, err := r.Value(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+	if err := r.Scan("three quarters"); err == nil || r.Valid {
+		t.Error("expected error and null Rat scanning invalid text")
+	}
+	if err := r.Scan(0.75); err == nil {
+		t.Error("expected error scanning a float")
+	}
+}
+
+func TestRatEqual(t *testing.T) {
+	if !MustRat("2/4").Equal(MustRat("1/2")) {
+		t.Error("2/4 should equal 1/2")
+	}
+	if MustRat("1/2").Equal(MustRat("1/3")) {
+		t.Error("1/2 should not equal 1/3")
+	}
+	if !(Rat{}).Equal(Rat{}) {
+		t.Error("null Rats should be equal")
+	}
+	if MustRat("0").Equal(Rat{}) || (Rat{}).Equal(MustRat("0")) {
+		t.Error("a null Rat should not equal a valid zero")
+	}
+
+	// a valid Rat without a value is treated as null
+	nilRat := Rat{Valid: true}
+	if !nilRat.Equal(Rat{}) || !nilRat.Equal(nilRat) || nilRat.Equal(MustRat("0")) || MustRat("0").Equal(nilRat) {
+		t.Error("a Rat with a nil value should equal null Rats only")
+	}
+	data, err := nilRat.MarshalJSON()
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "nil Rat json marshal")
+}
+
+func TestRatAliasing(t *testing.T) {
+	in := big.NewRat(3, 4)
+	r := RatFrom(in)
+	in.SetInt64(5)
+	if r.String() != "3/4" {
+		t.Errorf("RatFrom shares its input: %s", r)
+	}
+
+	out := r.Ptr()
+	out.SetInt64(5)
+	if r.String() != "3/4" {
+		t.Errorf("Ptr shares the value: %s", r)
+	}
+
+	// scanning into a copy must not change the original
+	cp := r
+	err := cp.Scan("1/3")
+	maybePanic(err)
+	if r.String() != "3/4" || cp.String() != "1/3" {
+		t.Errorf("Scan modified a shared big.Rat: %s, %s", r, cp)
+	}
+
+	if got, ok := r.AsAny().(*big.Rat); !ok || got == r.Rat || got.Cmp(r.Rat) != 0 {
+		t.Errorf("AsAny() should return a copy: %#v", r.AsAny())
+	}
+	if got := (Rat{}).AsAny(); got != nil {
+		t.Errorf("null AsAny() = %#v, want nil", got)
+	}
+	if RatFrom(nil).Valid {
+		t.Error("RatFrom(nil) should be null")
+	}
+}
+
+func TestMustRat(t *testing.T) {
+	assertPanics(t, func() { MustRat("1/0") }, "MustRat")
+}
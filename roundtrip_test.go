@@ -0,0 +1,127 @@
+package null
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestDatabaseRoundTrip passes every type through database/sql and the test driver,
+// checking the driver.Value the driver receives and the value scanned back.
+// EncryptedString can't scan into its zero value, so TestEncryptedString covers it instead.
+func TestDatabaseRoundTrip(t *testing.T) {
+	semver, err := SemverFrom("1.2.3-rc.1")
+	maybePanic(err)
+	color, err := ColorFrom("#ff800080")
+	maybePanic(err)
+	email, err := EmailFrom("user@example.com")
+	maybePanic(err)
+	lang, err := LangFrom("en-US")
+	maybePanic(err)
+	phone, err := PhoneFrom("+14155552671")
+	maybePanic(err)
+	decimal, err := DecimalFrom("-1.50")
+	maybePanic(err)
+	validatedString := NewValidatedString(func(string) error { return nil })
+	maybePanic(validatedString.SetValid("test"))
+	validatedInt := NewValidatedInt(func(int64) error { return nil })
+	maybePanic(validatedInt.SetValid(12345))
+
+	tests := []struct {
+		name   string
+		in     Nullable
+		stored driver.Value
+	}{
+		{"String", StringFrom("test"), "test"},
+		{"String null", NewString("", false), nil},
+		{"Int", IntFrom(12345), int64(12345)},
+		{"Int null", NewInt(0, false), nil},
+		{"Float", FloatFrom(1.2345), 1.2345},
+		{"Float null", NewFloat(0, false), nil},
+		{"Bool", BoolFrom(true), true},
+		{"Bool null", NewBool(false, false), nil},
+		{"Time", TimeFrom(timeValue1), timeValue1},
+		{"Time null", NewTime(time.Time{}, false), nil},
+		{"Timestamp", TimestampFrom(timestampValue), timestampValue},
+		{"Timestamp null", NewTimestamp(time.Time{}, false), nil},
+		{"Number int", NumberFrom("42"), int64(42)},
+		{"Number float", NumberFrom("4.2"), 4.2},
+		{"Number null", NewNumber("", false), nil},
+		{"Percent", PercentFrom(12.5), 12.5},
+		{"Percent null", NewPercent(0, false), nil},
+		{"Semver", semver, "1.2.3-rc.1"},
+		{"Semver null", Semver{}, nil},
+		{"Color", color, "#ff800080"},
+		{"Color null", Color{}, nil},
+		{"FormBool", FormBoolFrom(true), true},
+		{"FormBool null", NewFormBool(false, false), nil},
+		{"Int16", Int16From(-123), int64(-123)},
+		{"Int16 null", NewInt16(0, false), nil},
+		{"Int32", Int32From(-12345), int64(-12345)},
+		{"Int32 null", NewInt32(0, false), nil},
+		{"Uint", UintFrom(12345), int64(12345)},
+		{"Uint null", NewUint(0, false), nil},
+		{"ByteSize", ByteSizeFrom(1 << 20), int64(1 << 20)},
+		{"ByteSize null", NewByteSize(0, false), nil},
+		{"Month", MonthFrom(time.March), int64(3)},
+		{"Month null", NewMonth(0, false), nil},
+		{"EnumInt", EnumIntFrom(orderShipped), int64(orderShipped)},
+		{"EnumInt null", NewEnumInt[orderStatus](0, false), nil},
+		{"Tagged", TaggedFrom(int64(42)), int64(42)},
+		{"Tagged null", Tagged[int64]{}, nil},
+		{"Ints", Ints{IntFrom(1), NewInt(0, false), IntFrom(3)}, "{1,NULL,3}"},
+		{"Ints null", Ints(nil), nil},
+		{"CIString", CIStringFrom("Test"), "test"},
+		{"CIString null", NewCIString("", false), nil},
+		{"SecretString", SecretStringFrom("hunter2"), "hunter2"},
+		{"SecretString null", NewSecretString("", false), nil},
+		{"Decimal", decimal, "-1.50"},
+		{"Decimal null", Decimal{}, nil},
+		{"Email", email, "user@example.com"},
+		{"Email null", Email{}, nil},
+		{"Lang", lang, "en-US"},
+		{"Lang null", Lang{}, nil},
+		{"Phone", phone, "+14155552671"},
+		{"Phone null", Phone{}, nil},
+		{"Rat", MustRat("-3/4"), "-3/4"},
+		{"Rat null", Rat{}, nil},
+		{"Base64", Base64From([]byte("hello")), []byte("hello")},
+		{"Base64 null", Base64{}, nil},
+		{"ValidatedString", validatedString, "test"},
+		{"ValidatedString null", ValidatedString{}, nil},
+		{"ValidatedInt", validatedInt, int64(12345)},
+		{"ValidatedInt null", ValidatedInt{}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db := openFakeDB(t.Name())
+			defer db.Close()
+			_, err := db.Exec("INSERT", test.in)
+			maybePanic(err)
+
+			fakeStoresMu.Lock()
+			stored := fakeStores[t.Name()][0][0]
+			fakeStoresMu.Unlock()
+			if st, ok := stored.(time.Time); ok {
+				if !st.Equal(test.stored.(time.Time)) {
+					t.Errorf("driver received %v, want %v", stored, test.stored)
+				}
+			} else if !reflect.DeepEqual(stored, test.stored) {
+				t.Errorf("driver received %#v, want %#v", stored, test.stored)
+			}
+
+			out := reflect.New(reflect.TypeOf(test.in))
+			err = db.QueryRow("SELECT").Scan(out.Interface())
+			maybePanic(err)
+			equal, ok := callEqual(reflect.ValueOf(test.in), out.Elem())
+			if !ok {
+				// Ints has no Equal method
+				equal = reflect.DeepEqual(test.in, out.Elem().Interface())
+			}
+			if !equal {
+				t.Errorf("scanned %#v, want %#v", out.Elem().Interface(), test.in)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+)
+
+// RowScanner is a source of rows for ScanRow, such as *sql.Rows or *sql.Row.
+type RowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// ScanRow scans the current row of rows into dest, which must all be types from this package.
+// Unlike rows.Scan, it reports which column failed by index, along with the UnmarshalError of its type.
+func ScanRow(rows RowScanner, dest ...sql.Scanner) error {
+	values := make([]interface{}, len(dest))
+	for i, d := range dest {
+		if _, ok := d.(Nullable); !ok {
+			return fmt.Errorf("null: ScanRow: dest %d is %T, not a type from this package", i, d)
+		}
+		values[i] = new(interface{})
+	}
+	if err := rows.Scan(values...); err != nil {
+		return fmt.Errorf("null: ScanRow: %w", err)
+	}
+	for i, d := range dest {
+		if err := d.Scan(*values[i].(*interface{})); err != nil {
+			return fmt.Errorf("null: ScanRow: column %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// scanSource prepares a value passed to Scan.
+// The contents of sql.RawBytes are only valid until the next Scan, and the standard conversions
+// don't accept it, so it is replaced with a copy of its bytes.
+// Query builders sometimes pass Go integers that aren't valid driver values, such as int or int32;
+// these are widened to int64, so every Scan that accepts int64 accepts them too.
+// Values implementing driver.Valuer, such as sql.Null[T] or sql.NullInt64 passed through by some layers,
+// are replaced by the driver value they hold, so a null one scans as nil.
+// Pointers, such as the *T or **T some drivers pass back for sql.Out parameters, are followed to the value
+// they point to, with a nil pointer scanning as nil.
+func scanSource(value interface{}) interface{} {
+	value = derefSource(value)
+	if valuer, ok := value.(driver.Valuer); ok {
+		if inner, err := valuer.Value(); err == nil {
+			value = inner
+		}
+	}
+	switch v := value.(type) {
+	case sql.RawBytes:
+		return cloneBytes(v)
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int8:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case uint8:
+		return int64(v)
+	}
+	return value
+}
+
+// derefSource follows value through any number of pointers, returning nil if one of them is nil.
+func derefSource(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Ptr {
+		return value
+	}
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	return rv.Interface()
+}
+
+// cloneBytes returns a copy of b, or nil if b is nil.
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+// ScanStrings scans every row of a single-column result into a String, with NULL producing a null String.
+// It closes rows when done, and returns rows.Err() if iterating failed.
+func ScanStrings(rows *sql.Rows) ([]String, error) {
+	return scanColumn[String](rows, "ScanStrings")
+}
+
+// ScanInts scans every row of a single-column result into an Int, with NULL producing a null Int.
+// It closes rows when done, and returns rows.Err() if iterating failed.
+func ScanInts(rows *sql.Rows) ([]Int, error) {
+	return scanColumn[Int](rows, "ScanInts")
+}
+
+// ScanFloats scans every row of a single-column result into a Float, with NULL producing a null Float.
+// It closes rows when done, and returns rows.Err() if iterating failed.
+func ScanFloats(rows *sql.Rows) ([]Float, error) {
+	return scanColumn[Float](rows, "ScanFloats")
+}
+
+// scanColumn implements ScanStrings and friends. fn names the caller in errors.
+func scanColumn[T any, PT interface {
+	*T
+	sql.Scanner
+}](rows *sql.Rows, fn string) ([]T, error) {
+	defer rows.Close()
+	var out []T
+	for rows.Next() {
+		var v T
+		if err := rows.Scan(PT(&v)); err != nil {
+			return nil, fmt.Errorf("null: %s: row %d: %w", fn, len(out), err)
+		}
+		out = append(out, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("null: %s: %w", fn, err)
+	}
+	return out, nil
+}
@@ -0,0 +1,32 @@
+//go:build go1.22
+
+package null
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestScanSQLNull(t *testing.T) {
+	var i Int
+	err := i.Scan(sql.Null[int64]{V: 12345, Valid: true})
+	maybePanic(err)
+	assertInt(t, i, "scanned sql.Null[int64]")
+
+	err = i.Scan(sql.Null[int64]{V: 12345, Valid: false})
+	maybePanic(err)
+	assertNullInt(t, i, "scanned null sql.Null[int64]")
+
+	var s String
+	err = s.Scan(sql.Null[string]{V: "test", Valid: true})
+	maybePanic(err)
+	assertStr(t, s, "scanned sql.Null[string]")
+
+	err = s.Scan(sql.Null[string]{})
+	maybePanic(err)
+	assertNullStr(t, s, "scanned null sql.Null[string]")
+
+	err = s.Scan(sql.NullString{String: "test", Valid: true})
+	maybePanic(err)
+	assertStr(t, s, "scanned sql.NullString")
+}
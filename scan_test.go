@@ -0,0 +1,371 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScanRow(t *testing.T) {
+	db := openFakeDB(t.Name())
+	defer db.Close()
+	_, err := db.Exec("INSERT", "test", nil)
+	maybePanic(err)
+	_, err = db.Exec("INSERT", nil, int64(12345))
+	maybePanic(err)
+	_, err = db.Exec("INSERT", "test", "abc")
+	maybePanic(err)
+
+	rows, err := db.Query("SELECT")
+	maybePanic(err)
+	defer rows.Close()
+
+	var (
+		s String
+		i Int
+	)
+	rows.Next()
+	err = ScanRow(rows, &s, &i)
+	maybePanic(err)
+	assertStr(t, s, "first row")
+	assertNullInt(t, i, "first row")
+
+	rows.Next()
+	err = ScanRow(rows, &s, &i)
+	maybePanic(err)
+	assertNullStr(t, s, "second row")
+	assertInt(t, i, "second row")
+
+	rows.Next()
+	err = ScanRow(rows, &s, &i)
+	var unmarshalErr *UnmarshalError
+	if !errors.As(err, &unmarshalErr) || unmarshalErr.Type != "Int" || !strings.Contains(err.Error(), "column 1") {
+		t.Errorf("expected error naming column 1, not %v", err)
+	}
+
+	var wrongType sql.NullString
+	err = ScanRow(rows, &s, &wrongType)
+	if err == nil || !strings.Contains(err.Error(), "dest 1") {
+		t.Errorf("expected error naming dest 1, not %v", err)
+	}
+}
+
+// fakeRow is a RowScanner returning fixed values, like sql.Rows after the driver conversions.
+type fakeRow []interface{}
+
+func (r fakeRow) Scan(dest ...interface{}) error {
+	if len(dest) != len(r) {
+		return errors.New("wrong number of columns")
+	}
+	for i, v := range r {
+		*dest[i].(*interface{}) = v
+	}
+	return nil
+}
+
+func TestScanRowFakeSource(t *testing.T) {
+	var (
+		s  String
+		i  Int
+		f  Float
+		b  Bool
+		ts Timestamp
+	)
+	row := fakeRow{[]byte("test"), int64(12345), nil, true, timestampValue}
+	err := ScanRow(row, &s, &i, &f, &b, &ts)
+	maybePanic(err)
+	assertStr(t, s, "fake row")
+	assertInt(t, i, "fake row")
+	assertNullFloat(t, f, "fake row")
+	assertBool(t, b, "fake row")
+	assertTimestamp(t, ts, "fake row")
+
+	err = ScanRow(fakeRow{"test"}, &s, &i)
+	if err == nil || !strings.Contains(err.Error(), "wrong number of columns") {
+		t.Errorf("expected wrapped source error, not %v", err)
+	}
+
+	err = ScanRow(fakeRow{"test", true, "abc"}, &s, &b, &f)
+	var unmarshalErr *UnmarshalError
+	if !errors.As(err, &unmarshalErr) || unmarshalErr.Type != "Float" || !strings.Contains(err.Error(), "column 2") {
+		t.Errorf("expected error naming column 2, not %v", err)
+	}
+}
+
+func TestScanRawBytes(t *testing.T) {
+	buf := sql.RawBytes("test")
+	var first String
+	err := first.Scan(buf)
+	maybePanic(err)
+	copy(buf, "next")
+	var second String
+	err = second.Scan(buf)
+	maybePanic(err)
+	assertStr(t, first, "first scan of reused RawBytes")
+	if second.String != "next" {
+		t.Errorf("bad second scan: %s ≠ %s", second.String, "next")
+	}
+
+	buf = sql.RawBytes("12345")
+	var i Int
+	err = i.Scan(buf)
+	maybePanic(err)
+	var n Number
+	err = n.Scan(buf)
+	maybePanic(err)
+	copy(buf, "99999")
+	assertInt(t, i, "scanned RawBytes")
+	assertNumber(t, n, "12345", "scanned RawBytes")
+
+	buf = sql.RawBytes("1.2345")
+	var f Float
+	err = f.Scan(buf)
+	maybePanic(err)
+	assertFloat(t, f, "scanned RawBytes")
+
+	buf = sql.RawBytes("true")
+	var b Bool
+	err = b.Scan(buf)
+	maybePanic(err)
+	assertBool(t, b, "scanned RawBytes")
+
+	var null String
+	err = null.Scan(sql.RawBytes(nil))
+	maybePanic(err)
+	if !null.Valid || null.String != "" {
+		t.Error("nil RawBytes should scan like a nil []byte")
+	}
+}
+
+func TestScanBoxedInts(t *testing.T) {
+	for _, v := range []interface{}{int(12345), int32(12345), int64(12345), uint16(12345)} {
+		var i Int
+		err := i.Scan(v)
+		maybePanic(err)
+		assertInt(t, i, "scanned boxed int")
+
+		var ts Timestamp
+		err = ts.Scan(v)
+		maybePanic(err)
+		if !ts.Valid || !ts.Time.Equal(time.Unix(12345, 0)) {
+			t.Errorf("bad Timestamp scan of %T: %v", v, ts.Time)
+		}
+	}
+}
+
+func TestScanOutPointer(t *testing.T) {
+	// a driver filling an sql.Out parameter may pass the nullable result as **T
+	n := int64(12345)
+	p := &n
+	var i Int
+	err := i.Scan(&p)
+	maybePanic(err)
+	assertInt(t, i, "scanned **int64")
+
+	str := "test"
+	var s String
+	err = s.Scan(&str)
+	maybePanic(err)
+	assertStr(t, s, "scanned *string")
+
+	var nilPtr *int64
+	err = i.Scan(&nilPtr)
+	maybePanic(err)
+	assertNullInt(t, i, "scanned nil **int64")
+
+	err = s.Scan((*string)(nil))
+	maybePanic(err)
+	assertNullStr(t, s, "scanned nil *string")
+
+	out := sql.Out{Dest: &i}
+	err = out.Dest.(sql.Scanner).Scan(&p)
+	maybePanic(err)
+	assertInt(t, i, "scanned sql.Out destination")
+}
+
+func TestCloneBytes(t *testing.T) {
+	if cloneBytes(nil) != nil {
+		t.Error("cloneBytes(nil) should be nil")
+	}
+	b := []byte("test")
+	c := cloneBytes(b)
+	b[0] = 'x'
+	if string(c) != "test" {
+		t.Errorf("cloneBytes() shares memory: %s", c)
+	}
+}
+
+func TestScanColumn(t *testing.T) {
+	db := openFakeDB(t.Name())
+	defer db.Close()
+	for _, v := range []interface{}{"1", nil, "3.5"} {
+		_, err := db.Exec("INSERT", v)
+		maybePanic(err)
+	}
+
+	rows, err := db.Query("SELECT")
+	maybePanic(err)
+	strs, err := ScanStrings(rows)
+	maybePanic(err)
+	if len(strs) != 3 || !strs[0].Equal(StringFrom("1")) || strs[1].Valid || !strs[2].Equal(StringFrom("3.5")) {
+		t.Errorf("bad ScanStrings(): %#v", strs)
+	}
+	if err := rows.Err(); err != nil || rows.Next() {
+		t.Error("rows should be closed")
+	}
+
+	rows, err = db.Query("SELECT")
+	maybePanic(err)
+	floats, err := ScanFloats(rows)
+	maybePanic(err)
+	if len(floats) != 3 || !floats[0].Equal(FloatFrom(1)) || floats[1].Valid || !floats[2].Equal(FloatFrom(3.5)) {
+		t.Errorf("bad ScanFloats(): %#v", floats)
+	}
+
+	rows, err = db.Query("SELECT")
+	maybePanic(err)
+	ints, err := ScanInts(rows)
+	if err == nil || !strings.Contains(err.Error(), "null: ScanInts: row 2") {
+		t.Errorf("expected error for row 2, got %v", err)
+	}
+	if ints != nil {
+		t.Errorf("expected no result on error, got %#v", ints)
+	}
+}
+
+// TestScanSources scans every Scanner in this package from the wrapped forms of a string that drivers
+// and query layers pass: sql.RawBytes, a pointer, and a driver.Valuer. Each must give the same result
+// as scanning the string itself.
+func TestScanSources(t *testing.T) {
+	decrypt := func(b []byte) ([]byte, error) { return b[1:], nil }
+	tests := []struct {
+		name string
+		new  func() sql.Scanner
+		src  string
+	}{
+		{"String", func() sql.Scanner { return new(String) }, "test"},
+		{"Int", func() sql.Scanner { return new(Int) }, "12345"},
+		{"Float", func() sql.Scanner { return new(Float) }, "1.2345"},
+		{"Bool", func() sql.Scanner { return new(Bool) }, "true"},
+		{"Time", func() sql.Scanner { return new(Time) }, "2012-12-21T21:21:21Z"},
+		{"Timestamp", func() sql.Scanner { return new(Timestamp) }, "2012-12-21 21:21:21"},
+		{"Number", func() sql.Scanner { return new(Number) }, "1.50"},
+		{"Percent", func() sql.Scanner { return new(Percent) }, "50"},
+		{"Semver", func() sql.Scanner { return new(Semver) }, "1.2.3-rc.1"},
+		{"Color", func() sql.Scanner { return new(Color) }, "#ff8000"},
+		{"FormBool", func() sql.Scanner { return new(FormBool) }, "true"},
+		{"EnumInt", func() sql.Scanner { return new(EnumInt[orderStatus]) }, "1"},
+		{"Month", func() sql.Scanner { return new(Month) }, "3"},
+		{"Int32", func() sql.Scanner { return new(Int32) }, "123"},
+		{"Int16", func() sql.Scanner { return new(Int16) }, "123"},
+		{"Uint", func() sql.Scanner { return new(Uint) }, "123"},
+		{"SecretString", func() sql.Scanner { return new(SecretString) }, "hunter2"},
+		{"CIString", func() sql.Scanner { return new(CIString) }, "Active"},
+		{"Tagged", func() sql.Scanner { return new(Tagged[String]) }, "test"},
+		{"Ints", func() sql.Scanner { return new(Ints) }, "{1,null,3}"},
+		{"Email", func() sql.Scanner { return new(Email) }, "gopher@example.com"},
+		{"ByteSize", func() sql.Scanner { return new(ByteSize) }, "1024"},
+		{"Phone", func() sql.Scanner { return new(Phone) }, "+1 415 555 2671"},
+		{"EncryptedString", func() sql.Scanner { s := NewEncryptedString(nil, decrypt); return &s }, "\x00test"},
+		{"Decimal", func() sql.Scanner { return new(Decimal) }, "1.50"},
+		{"Rat", func() sql.Scanner { return new(Rat) }, "3/4"},
+		{"Lang", func() sql.Scanner { return new(Lang) }, "en-us"},
+		{"Base64", func() sql.Scanner { return new(Base64) }, "hello"},
+		{"ValidatedString", func() sql.Scanner {
+			s := NewValidatedString(func(string) error { return nil })
+			return &s
+		}, "test"},
+		{"ValidatedInt", func() sql.Scanner {
+			i := NewValidatedInt(func(int64) error { return nil })
+			return &i
+		}, "12345"},
+	}
+	covered := map[string]bool{}
+	for _, test := range tests {
+		covered[test.name] = true
+	}
+	for name := range fuzzTypes() {
+		if !covered[name] {
+			t.Errorf("%s: missing from TestScanSources", name)
+		}
+	}
+
+	for _, test := range tests {
+		want := test.new()
+		if err := want.Scan(test.src); err != nil {
+			t.Errorf("%s: scanning %q: %v", test.name, test.src, err)
+			continue
+		}
+		str := test.src
+		raw := sql.RawBytes(test.src)
+		sources := []interface{}{
+			raw,
+			&str,
+			sql.NullString{String: test.src, Valid: true},
+		}
+		for _, src := range sources {
+			got := test.new()
+			if err := got.Scan(src); err != nil {
+				t.Errorf("%s: scanning %T: %v", test.name, src, err)
+				continue
+			}
+			// the driver reuses RawBytes for the next row
+			for i := range raw {
+				raw[i] = 'x'
+			}
+			if g, w := fmt.Sprintf("%#v", got), fmt.Sprintf("%#v", want); g != w {
+				t.Errorf("%s: scanning %T gave %s, want %s", test.name, src, g, w)
+			}
+			copy(raw, test.src)
+		}
+	}
+}
+
+func TestScanIntegerSources(t *testing.T) {
+	// every integer type scans through Int, so accepts the same input
+	tests := []struct {
+		name string
+		new  func() sql.Scanner
+	}{
+		{"Int", func() sql.Scanner { return new(Int) }},
+		{"Int32", func() sql.Scanner { return new(Int32) }},
+		{"Int16", func() sql.Scanner { return new(Int16) }},
+		{"Uint", func() sql.Scanner { return new(Uint) }},
+		{"ByteSize", func() sql.Scanner { return new(ByteSize) }},
+		{"EnumInt", func() sql.Scanner { return new(EnumInt[orderStatus]) }},
+		{"Month", func() sql.Scanner { return new(Month) }},
+	}
+	sources := []struct {
+		src  interface{}
+		want int64
+	}{
+		{true, 1},
+		{json.Number("2"), 2},
+		{sql.NullBool{Bool: true, Valid: true}, 1},
+	}
+	for _, test := range tests {
+		for _, source := range sources {
+			want := test.new()
+			maybePanic(want.Scan(source.want))
+			got := test.new()
+			if err := got.Scan(source.src); err != nil {
+				t.Errorf("%s: scanning %T: %v", test.name, source.src, err)
+				continue
+			}
+			if g, w := fmt.Sprintf("%#v", got), fmt.Sprintf("%#v", want); g != w {
+				t.Errorf("%s: scanning %T gave %s, want %s", test.name, source.src, g, w)
+			}
+		}
+
+		bad := test.new()
+		if err := bad.Scan(json.Number("1.5")); err == nil {
+			t.Errorf("%s: expected error scanning fractional json.Number", test.name)
+		} else if !strings.Contains(err.Error(), "null."+test.name) {
+			t.Errorf("%s: error doesn't name the type: %v", test.name, err)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package null
+
+// The JSONSchema methods of the types in this package return the JSON Schema of their JSON encoding,
+// which always allows null. They return a new map on every call, so callers may modify it,
+// for example to add a description, before passing it to their schema generator.
+// github.com/invopop/jsonschema doesn't call these methods, as it expects its own schema type;
+// the github.com/zero-pkg/null/nullschema module provides a Mapper for it that does.
+
+// nullableSchema returns a schema for a JSON value of type typ or null.
+// Extra types, such as "string" for values that may also be encoded as strings, come before null.
+func nullableSchema(typ string, extra ...string) map[string]interface{} {
+	types := append(append([]string{typ}, extra...), "null")
+	return map[string]interface{}{"type": types}
+}
+
+// nullableEnumSchema returns a schema for one of values, all of type typ, or null.
+func nullableEnumSchema(typ string, values []interface{}) map[string]interface{} {
+	schema := nullableSchema(typ)
+	schema["enum"] = append(values, nil)
+	return schema
+}
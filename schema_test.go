@@ -0,0 +1,86 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type schemer interface {
+	JSONSchema() map[string]interface{}
+}
+
+func TestJSONSchema(t *testing.T) {
+	tests := []struct {
+		name string
+		v    schemer
+		want string
+	}{
+		{"String", String{}, `{"type":["string","null"]}`},
+		{"Int", Int{}, `{"type":["integer","null"]}`},
+		{"Float", Float{}, `{"type":["number","null"]}`},
+		{"Bool", Bool{}, `{"type":["boolean","null"]}`},
+		{"Time", Time{}, `{"format":"date-time","type":["string","null"]}`},
+		{"Timestamp", Timestamp{}, `{"description":"Unix time in seconds, or an RFC 3339 time","format":"date-time","type":["number","string","null"]}`},
+		{"Number", Number{}, `{"type":["number","null"]}`},
+		{"Percent", Percent{}, `{"maximum":100,"minimum":0,"type":["number","null"]}`},
+		{"Semver", Semver{}, `{"pattern":"^[0-9]+\\.[0-9]+\\.[0-9]+(-[0-9A-Za-z.-]+)?(\\+[0-9A-Za-z.-]+)?$","type":["string","null"]}`},
+		{"Color", Color{}, `{"pattern":"^#[0-9a-f]{6}([0-9a-f]{2})?$","type":["string","null"]}`},
+		{"FormBool", FormBool{}, `{"type":["boolean","null"]}`},
+		{"EnumInt", EnumInt[orderStatus]{}, `{"enum":["pending","shipped","delivered",null],"type":["string","null"]}`},
+		{"Month", Month{}, `{"maximum":12,"minimum":1,"type":["integer","null"]}`},
+		{"Int32", Int32{}, `{"maximum":2147483647,"minimum":-2147483648,"type":["integer","null"]}`},
+		{"Int16", Int16{}, `{"maximum":32767,"minimum":-32768,"type":["integer","null"]}`},
+		{"Uint", Uint{}, `{"minimum":0,"type":["integer","null"]}`},
+		{"SecretString", SecretString{}, `{"type":["string","null"]}`},
+		{"CIString", CIString{}, `{"type":["string","null"]}`},
+		{"Tagged", Tagged[Int]{}, `{"additionalProperties":false,"properties":{"valid":{"type":"boolean"},"value":{"type":["integer","null"]}},"required":["value","valid"],"type":["object","null"]}`},
+		{"Ints", Ints{}, `{"items":{"type":["integer","null"]},"type":["array","null"]}`},
+		{"Email", Email{}, `{"format":"email","type":["string","null"]}`},
+		{"ByteSize", ByteSize{}, `{"pattern":"^[0-9]+([kKMGTPE]i?)?B$","type":["string","null"]}`},
+		{"Phone", Phone{}, `{"pattern":"^\\+[1-9][0-9]{1,14}$","type":["string","null"]}`},
+		{"EncryptedString", EncryptedString{}, `{"type":["string","null"]}`},
+		{"Decimal", Decimal{}, `{"type":["number","null"]}`},
+		{"Rat", Rat{}, `{"pattern":"^-?[0-9]+(/[0-9]+)?$","type":["string","null"]}`},
+		{"Lang", Lang{}, `{"type":["string","null"]}`},
+		{"Base64", Base64{}, `{"contentEncoding":"base64","type":["string","null"]}`},
+		{"ValidatedString", ValidatedString{}, `{"type":["string","null"]}`},
+		{"ValidatedInt", ValidatedInt{}, `{"type":["integer","null"]}`},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(test.v.JSONSchema())
+		maybePanic(err)
+		assertJSONEquals(t, data, test.want, test.name+" schema")
+	}
+}
+
+func TestJSONSchemaOptions(t *testing.T) {
+	defer func(prev bool) { IntMarshalLargeAsString = prev }(IntMarshalLargeAsString)
+	defer func(prev bool) { EnumIntMarshalCode = prev }(EnumIntMarshalCode)
+	defer func(prev bool) { MonthMarshalAsName = prev }(MonthMarshalAsName)
+	IntMarshalLargeAsString, EnumIntMarshalCode, MonthMarshalAsName = true, true, true
+
+	tests := []struct {
+		name string
+		v    schemer
+		want string
+	}{
+		{"Int", Int{}, `{"type":["integer","string","null"]}`},
+		{"EnumInt", EnumInt[orderStatus]{}, `{"enum":[1,2,3,null],"type":["integer","null"]}`},
+		{"Month", Month{}, `{"enum":["January","February","March","April","May","June","July",` +
+			`"August","September","October","November","December",null],"type":["string","null"]}`},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(test.v.JSONSchema())
+		maybePanic(err)
+		assertJSONEquals(t, data, test.want, test.name+" schema with option")
+	}
+}
+
+func TestJSONSchemaIsFresh(t *testing.T) {
+	schema := TimeFrom(time.Now()).JSONSchema()
+	schema["description"] = "changed"
+	if _, ok := (Time{}).JSONSchema()["description"]; ok {
+		t.Error("JSONSchema() should return a new map every time")
+	}
+}
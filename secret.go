@@ -0,0 +1,145 @@
+package null
+
+import (
+	"crypto/subtle"
+	"database/sql/driver"
+)
+
+// secretRedacted replaces the value of a valid SecretString in JSON, text, and formatted output.
+const secretRedacted = "***"
+
+// SecretString is a nullable string for sensitive values, such as personal data.
+// It is stored in SQL as its real value, but marshals to "***" in JSON and text, and formats as "***",
+// so it doesn't leak into logs. Use Reveal to read the real value.
+// It will marshal to null if null.
+type SecretString struct {
+	secret string
+	Valid  bool
+}
+
+// NewSecretString creates a new SecretString
+func NewSecretString(s string, valid bool) SecretString {
+	return SecretString{secret: s, Valid: valid}
+}
+
+// SecretStringFrom creates a new SecretString that will always be valid.
+func SecretStringFrom(s string) SecretString {
+	return NewSecretString(s, true)
+}
+
+// Reveal returns the real value, or a blank string if this SecretString is null.
+func (s SecretString) Reveal() string {
+	if !s.Valid {
+		return ""
+	}
+	return s.secret
+}
+
+// String implements fmt.Stringer.
+// It returns "***" if this SecretString is valid, otherwise a blank string.
+func (s SecretString) String() string {
+	if !s.Valid {
+		return ""
+	}
+	return secretRedacted
+}
+
+// JSONSchema returns the JSON Schema of this SecretString's JSON encoding: a string, which is always "***", or null.
+func (s SecretString) JSONSchema() map[string]interface{} {
+	return nullableSchema("string")
+}
+
+// GoString implements fmt.GoStringer, so %#v doesn't print the real value either.
+func (s SecretString) GoString() string {
+	if !s.Valid {
+		return "null.NewSecretString(\"\", false)"
+	}
+	return "null.SecretStringFrom(\"" + secretRedacted + "\")"
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *SecretString) Scan(value interface{}) error {
+	return reportError(s.scan(value))
+}
+
+func (s *SecretString) scan(value interface{}) error {
+	var str String
+	if err := str.NullString.Scan(scanSource(value)); err != nil {
+		*s = SecretString{}
+		return newScanError("SecretString", value, err)
+	}
+	*s = NewSecretString(str.String, str.Valid)
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It stores the real value.
+func (s SecretString) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.secret, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports the same input as String, and keeps the real value.
+func (s *SecretString) UnmarshalJSON(data []byte) error {
+	return reportError(s.unmarshalJSON(data))
+}
+
+func (s *SecretString) unmarshalJSON(data []byte) error {
+	var str String
+	if err := str.unmarshalJSON(data); err != nil {
+		*s = SecretString{}
+		return retypeError("SecretString", err)
+	}
+	*s = NewSecretString(str.String, str.Valid)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null SecretString if the input is a blank string.
+func (s *SecretString) UnmarshalText(text []byte) error {
+	*s = NewSecretString(string(text), len(text) > 0)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this SecretString is null, otherwise "***".
+func (s SecretString) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + secretRedacted + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this SecretString is null, otherwise "***".
+func (s SecretString) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Reset makes this SecretString null and clears its value, so a reused SecretString doesn't keep previous data.
+func (s *SecretString) Reset() {
+	*s = SecretString{}
+}
+
+// IsZero returns true for null SecretStrings.
+func (s SecretString) IsZero() bool {
+	return !s.Valid
+}
+
+// AsAny returns nil if this SecretString is null, otherwise "***".
+// Like MarshalJSON, it never returns the real value.
+func (s SecretString) AsAny() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return secretRedacted
+}
+
+// Equal returns true if both secrets have the same value or are both null.
+// The values are compared in constant time.
+func (s SecretString) Equal(other SecretString) bool {
+	return s.Valid == other.Valid && (!s.Valid || subtle.ConstantTimeCompare([]byte(s.secret), []byte(other.secret)) == 1)
+}
@@ -0,0 +1,76 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSecretStringRedacts(t *testing.T) {
+	s := SecretStringFrom("hunter2")
+
+	data, err := json.Marshal(s)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"***"`, "secret json marshal")
+
+	data, err = s.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "***", "secret text marshal")
+
+	for _, format := range []string{"%v", "%s", "%+v", "%#v"} {
+		if out := fmt.Sprintf(format, struct{ S SecretString }{s}); strings.Contains(out, "hunter2") {
+			t.Errorf("%s leaked the secret: %s", format, out)
+		}
+	}
+
+	v, err := s.Value()
+	maybePanic(err)
+	if v != "hunter2" {
+		t.Errorf("Value() should not redact, got %#v", v)
+	}
+	if s.Reveal() != "hunter2" {
+		t.Errorf("Reveal() = %q", s.Reveal())
+	}
+}
+
+func TestSecretStringNull(t *testing.T) {
+	null := NewSecretString("hunter2", false)
+	data, err := json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null secret json marshal")
+
+	v, err := null.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null value should be nil, not %#v", v)
+	}
+	if null.Reveal() != "" {
+		t.Errorf("Reveal() of null = %q", null.Reveal())
+	}
+}
+
+func TestSecretStringInput(t *testing.T) {
+	var s SecretString
+	err := json.Unmarshal([]byte(`"hunter2"`), &s)
+	maybePanic(err)
+	if !s.Equal(SecretStringFrom("hunter2")) {
+		t.Errorf("bad unmarshal: %s", s.Reveal())
+	}
+
+	err = s.Scan([]byte("swordfish"))
+	maybePanic(err)
+	if s.Reveal() != "swordfish" {
+		t.Errorf("bad scan: %s", s.Reveal())
+	}
+
+	err = json.Unmarshal(nullJSON, &s)
+	maybePanic(err)
+	if s.Valid {
+		t.Error("null json should be invalid")
+	}
+
+	if s.Equal(SecretStringFrom("")) || !SecretStringFrom("a").Equal(SecretStringFrom("a")) {
+		t.Error("bad Equal")
+	}
+}
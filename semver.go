@@ -0,0 +1,347 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Semver is a nullable semantic version in the form MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD],
+// as described by https://semver.org. It marshals to its version string, or null if null.
+type Semver struct {
+	Major, Minor, Patch uint64
+	// Prerelease holds the dot-separated pre-release identifiers, without the leading '-'.
+	Prerelease string
+	// Build holds the dot-separated build metadata, without the leading '+'.
+	Build string
+	Valid bool
+}
+
+// SemverFrom parses s and returns a valid Semver.
+// It returns an error and a null Semver if s is not a valid version.
+func SemverFrom(s string) (Semver, error) {
+	v, err := parseSemver(s)
+	if err != nil {
+		return Semver{}, newUnmarshalError("Semver", err)
+	}
+	return v, nil
+}
+
+// MustSemver is like SemverFrom but panics if s is not a valid version.
+// It simplifies initializing fixtures and package-level variables, like regexp.MustCompile.
+func MustSemver(s string) Semver {
+	v, err := SemverFrom(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns the version string, or a blank string if this Semver is null.
+func (v Semver) String() string {
+	if !v.Valid {
+		return ""
+	}
+	s := strconv.FormatUint(v.Major, 10) + "." + strconv.FormatUint(v.Minor, 10) + "." + strconv.FormatUint(v.Patch, 10)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil input.
+func (v *Semver) Scan(value interface{}) error {
+	return reportError(v.scan(value))
+}
+
+func (v *Semver) scan(value interface{}) error {
+	var err error
+	switch x := scanSource(value).(type) {
+	case nil:
+		*v = Semver{}
+		return nil
+	case string:
+		*v, err = parseSemver(x)
+	case []byte:
+		*v, err = parseSemver(string(x))
+	default:
+		err = errUnsupportedScanType
+	}
+	if err != nil {
+		*v = Semver{}
+		return newScanError("Semver", value, err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It stores the version string.
+func (v Semver) Value() (driver.Value, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (v *Semver) UnmarshalJSON(data []byte) error {
+	return reportError(v.unmarshalJSON(data))
+}
+
+func (v *Semver) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*v = Semver{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		*v = Semver{}
+		return newUnmarshalError("Semver", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	parsed, err := parseSemver(str)
+	if err != nil {
+		*v = Semver{}
+		return newUnmarshalError("Semver", err)
+	}
+	*v = parsed
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Semver if the input is blank or "null".
+func (v *Semver) UnmarshalText(text []byte) error {
+	return reportError(v.unmarshalText(text))
+}
+
+func (v *Semver) unmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		*v = Semver{}
+		return nil
+	}
+	parsed, err := parseSemver(str)
+	if err != nil {
+		*v = Semver{}
+		return newUnmarshalError("Semver", err)
+	}
+	*v = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Semver is null.
+func (v Semver) MarshalJSON() ([]byte, error) {
+	if !v.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(v.String())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Semver is null.
+func (v Semver) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// Reset makes this Semver null and clears its value, so a reused Semver doesn't keep previous data.
+func (v *Semver) Reset() {
+	*v = Semver{}
+}
+
+// IsZero returns true for null Semvers.
+func (v Semver) IsZero() bool {
+	return !v.Valid
+}
+
+// AsAny returns nil if this Semver is null, otherwise the version string.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (v Semver) AsAny() interface{} {
+	if !v.Valid {
+		return nil
+	}
+	return v.String()
+}
+
+// Compare returns -1, 0 or +1 depending on whether v has a lower, the same or a higher precedence than other.
+// Build metadata is ignored. A null Semver sorts before all valid ones.
+func (v Semver) Compare(other Semver) int {
+	if c, ok := compareNull(v.Valid, other.Valid); ok {
+		return c
+	}
+	return compareSemver(v, other)
+}
+
+// Equal returns true if both versions have the same precedence or are both null.
+// Build metadata is ignored, so 1.0.0+a and 1.0.0+b are Equal.
+func (v Semver) Equal(other Semver) bool {
+	return v.Compare(other) == 0
+}
+
+// LessThan returns true if v has a lower precedence than other.
+// It returns false if either version is null.
+func (v Semver) LessThan(other Semver) bool {
+	return v.Valid && other.Valid && compareSemver(v, other) < 0
+}
+
+// compareSemver compares the precedence of two valid versions, ignoring build metadata.
+func compareSemver(a, b Semver) int {
+	if c := compareUint(a.Major, b.Major); c != 0 {
+		return c
+	}
+	if c := compareUint(a.Minor, b.Minor); c != 0 {
+		return c
+	}
+	if c := compareUint(a.Patch, b.Patch); c != 0 {
+		return c
+	}
+	return comparePrerelease(a.Prerelease, b.Prerelease)
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease compares pre-release versions. A version without one has the higher precedence.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if c := comparePrereleaseIdent(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(as)), uint64(len(bs)))
+}
+
+// comparePrereleaseIdent compares single pre-release identifiers.
+// Numeric identifiers compare numerically and have a lower precedence than alphanumeric ones.
+func comparePrereleaseIdent(a, b string) int {
+	an, bn := isNumericIdent(a), isNumericIdent(b)
+	switch {
+	case an && bn:
+		if c := compareUint(uint64(len(a)), uint64(len(b))); c != 0 {
+			return c
+		}
+	case an:
+		return -1
+	case bn:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// parseSemver parses a version string.
+func parseSemver(s string) (Semver, error) {
+	v := Semver{Valid: true}
+	rest := s
+	if i := strings.IndexByte(rest, '+'); i >= 0 {
+		v.Build = rest[i+1:]
+		rest = rest[:i]
+		if !validIdents(v.Build, false) {
+			return Semver{}, invalidSemver(s)
+		}
+	}
+	if i := strings.IndexByte(rest, '-'); i >= 0 {
+		v.Prerelease = rest[i+1:]
+		rest = rest[:i]
+		if !validIdents(v.Prerelease, true) {
+			return Semver{}, invalidSemver(s)
+		}
+	}
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 {
+		return Semver{}, invalidSemver(s)
+	}
+	nums := [3]*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		if !isNumericIdent(part) {
+			return Semver{}, invalidSemver(s)
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return Semver{}, invalidSemver(s)
+		}
+		*nums[i] = n
+	}
+	return v, nil
+}
+
+func invalidSemver(s string) error {
+	return errors.New("invalid semantic version " + strconv.Quote(s))
+}
+
+// validIdents reports whether s is a non-empty list of dot-separated identifiers
+// made of [0-9A-Za-z-]. If noLeadingZeros is set, numeric identifiers can't have leading zeros.
+func validIdents(s string, noLeadingZeros bool) bool {
+	for _, ident := range strings.Split(s, ".") {
+		if ident == "" {
+			return false
+		}
+		for _, c := range ident {
+			if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-') {
+				return false
+			}
+		}
+		if noLeadingZeros && isDigits(ident) && !isNumericIdent(ident) {
+			return false
+		}
+	}
+	return true
+}
+
+// isNumericIdent reports whether s is a number without leading zeros.
+func isNumericIdent(s string) bool {
+	return isDigits(s) && (s == "0" || s[0] != '0')
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// JSONSchema returns the JSON Schema of this Semver's JSON encoding: a semantic version string or null.
+func (v Semver) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("string")
+	schema["pattern"] = `^[0-9]+\.[0-9]+\.[0-9]+(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Semver as the Go code that creates it.
+func (v Semver) GoString() string {
+	if !v.Valid {
+		return `null.Semver{}`
+	}
+	return fmt.Sprintf("null.Semver{Major: %d, Minor: %d, Patch: %d, Prerelease: %q, Build: %q, Valid: true}",
+		v.Major, v.Minor, v.Patch, v.Prerelease, v.Build)
+}
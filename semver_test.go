@@ -0,0 +1,199 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestUnmarshalSemver(t *testing.T) {
+	var v Semver
+	err := json.Unmarshal([]byte(`"1.2.3-rc.1+build.5"`), &v)
+	maybePanic(err)
+	want := Semver{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5", Valid: true}
+	if v != want {
+		t.Errorf("bad semver: %+v ≠ %+v", v, want)
+	}
+
+	var null Semver
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should produce a null Semver")
+	}
+
+	var badType Semver
+	if err := json.Unmarshal(intJSON, &badType); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestSemverInvalid(t *testing.T) {
+	for _, input := range []string{
+		"", "1", "1.2", "1.2.3.4", "v1.2.3", "01.2.3", "1.02.3", "1.2.03",
+		"1.2.3-", "1.2.3+", "1.2.3-rc..1", "1.2.3-01", "1.2.3-rc_1", "1.2.3+b@d", "a.b.c", "-1.2.3",
+	} {
+		v := Semver{Major: 1, Valid: true}
+		err := v.UnmarshalText([]byte(input))
+		if input == "" {
+			maybePanic(err)
+		} else {
+			var unmarshalErr *UnmarshalError
+			if !errors.As(err, &unmarshalErr) || unmarshalErr.Type != "Semver" {
+				t.Errorf("expected UnmarshalError for %q, not %v", input, err)
+			}
+		}
+		if v.Valid {
+			t.Errorf("%q should produce a null Semver", input)
+		}
+	}
+
+	var v Semver
+	if err := json.Unmarshal([]byte(`"1.2"`), &v); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMarshalSemver(t *testing.T) {
+	for _, input := range []string{"0.0.0", "1.2.3", "1.2.3-alpha.1", "1.2.3+build", "1.0.0-x-y.0+sha.0abc"} {
+		var v Semver
+		err := v.UnmarshalText([]byte(input))
+		maybePanic(err)
+		data, err := json.Marshal(v)
+		maybePanic(err)
+		assertJSONEquals(t, data, `"`+input+`"`, "json marshal")
+		data, err = v.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, data, input, "text marshal")
+	}
+
+	data, err := json.Marshal(Semver{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = Semver{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestSemverScanValue(t *testing.T) {
+	var v Semver
+	err := v.Scan("1.2.3")
+	maybePanic(err)
+	if val, err := v.Value(); val != "1.2.3" || err != nil {
+		t.Error("bad value or err:", val, err)
+	}
+
+	err = v.Scan([]byte("2.0.0-beta"))
+	maybePanic(err)
+	if v.Major != 2 || v.Prerelease != "beta" {
+		t.Errorf("bad scanned semver: %+v", v)
+	}
+
+	err = v.Scan(nil)
+	maybePanic(err)
+	if v.Valid {
+		t.Error("scanned nil should be null")
+	}
+	if val, err := v.Value(); val != nil || err != nil {
+		t.Error("bad value or err:", val, err)
+	}
+
+	if err := v.Scan("nope"); err == nil {
+		t.Error("expected error")
+	}
+	if err := v.Scan(int64(1)); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestSemverOrdering(t *testing.T) {
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0", "10.0.0",
+	}
+	versions := make([]Semver, len(ordered))
+	for i, s := range ordered {
+		maybePanic(versions[i].UnmarshalText([]byte(s)))
+	}
+	for i := range versions {
+		for j := range versions {
+			if got := versions[i].LessThan(versions[j]); got != (i < j) {
+				t.Errorf("%s.LessThan(%s) = %t", ordered[i], ordered[j], got)
+			}
+			if got := versions[i].Equal(versions[j]); got != (i == j) {
+				t.Errorf("%s.Equal(%s) = %t", ordered[i], ordered[j], got)
+			}
+		}
+	}
+}
+
+func TestSemverFrom(t *testing.T) {
+	v, err := SemverFrom("1.0.0-alpha+001")
+	maybePanic(err)
+	if want := (Semver{Major: 1, Prerelease: "alpha", Build: "001", Valid: true}); v != want {
+		t.Errorf("bad SemverFrom(): %+v ≠ %+v", v, want)
+	}
+
+	v, err = SemverFrom("1.0")
+	var unmarshalErr *UnmarshalError
+	if !errors.As(err, &unmarshalErr) || unmarshalErr.Type != "Semver" {
+		t.Errorf("expected UnmarshalError, not %v", err)
+	}
+	if v.Valid {
+		t.Error("SemverFrom() of an invalid version should be null")
+	}
+}
+
+func TestMustSemver(t *testing.T) {
+	if v := MustSemver("1.2.3"); v != (Semver{Major: 1, Minor: 2, Patch: 3, Valid: true}) {
+		t.Errorf("bad MustSemver(): %+v", v)
+	}
+	assertPanics(t, func() { MustSemver("1.0") }, "MustSemver(1.0)")
+}
+
+func TestSemverCompare(t *testing.T) {
+	mustSemver := func(s string) Semver {
+		v, err := SemverFrom(s)
+		maybePanic(err)
+		return v
+	}
+	tests := []struct {
+		a, b Semver
+		want int
+	}{
+		{mustSemver("1.0.0-alpha"), mustSemver("1.0.0"), -1},
+		{mustSemver("1.0.0"), mustSemver("1.0.0-alpha"), 1},
+		{mustSemver("1.0.0-alpha"), mustSemver("1.0.0-alpha.1"), -1},
+		{mustSemver("1.0.0-alpha.1"), mustSemver("1.0.0-alpha.beta"), -1},
+		{mustSemver("1.0.0-beta.2"), mustSemver("1.0.0-beta.11"), -1},
+		{mustSemver("1.0.0+build.1"), mustSemver("1.0.0+build.2"), 0},
+		{mustSemver("1.0.0-rc.1+a"), mustSemver("1.0.0-rc.1"), 0},
+		{mustSemver("2.0.0"), mustSemver("10.0.0"), -1},
+		{Semver{}, mustSemver("0.0.0"), -1},
+		{mustSemver("0.0.0"), Semver{}, 1},
+		{Semver{}, Semver{}, 0},
+	}
+	for _, test := range tests {
+		if got := test.a.Compare(test.b); got != test.want {
+			t.Errorf("%q.Compare(%q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestSemverEqual(t *testing.T) {
+	var a, b Semver
+	maybePanic(a.UnmarshalText([]byte("1.0.0+a")))
+	maybePanic(b.UnmarshalText([]byte("1.0.0+b")))
+	if !a.Equal(b) {
+		t.Error("build metadata should be ignored by Equal")
+	}
+	if !(Semver{}).Equal(Semver{}) {
+		t.Error("null Semvers should be Equal")
+	}
+	if a.Equal(Semver{}) || a.LessThan(Semver{}) || (Semver{}).LessThan(a) {
+		t.Error("null and valid Semvers should not be Equal or ordered")
+	}
+	if a.IsZero() || !(Semver{}).IsZero() {
+		t.Error("unexpected IsZero")
+	}
+}
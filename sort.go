@@ -0,0 +1,79 @@
+package null
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// compareNull orders a null value before a valid one, and two null values as equal.
+// It returns false as a second value if both are valid, and have to be compared by value.
+func compareNull(aValid, bValid bool) (int, bool) {
+	switch {
+	case aValid && bValid:
+		return 0, false
+	case aValid:
+		return 1, true
+	case bValid:
+		return -1, true
+	}
+	return 0, true
+}
+
+// compareInt returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b.
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareFloat is like compareInt, but orders NaN before all other values and equal to itself.
+func compareFloat(a, b float64) int {
+	aNaN, bNaN := math.IsNaN(a), math.IsNaN(b)
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN || a < b:
+		return -1
+	case bNaN || a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareBool is like compareInt, ordering false before true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case b:
+		return -1
+	}
+	return 1
+}
+
+// compareTime is like compareInt, ordering earlier times first.
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+// SortStrings sorts s in increasing order, keeping the order of equal elements.
+// Null Strings sort first, or last if nullsLast is true.
+func SortStrings(s []String, nullsLast bool) {
+	sort.SliceStable(s, func(i, j int) bool {
+		if nullsLast && s[i].Valid != s[j].Valid {
+			return s[i].Valid
+		}
+		return s[i].Less(s[j])
+	})
+}
@@ -0,0 +1,66 @@
+package null
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSortStrings(t *testing.T) {
+	null := NewString("", false)
+	input := []String{StringFrom("b"), null, StringFrom("a"), StringFrom(""), null, StringFrom("c")}
+
+	first := append([]String(nil), input...)
+	SortStrings(first, false)
+	wantFirst := []String{null, null, StringFrom(""), StringFrom("a"), StringFrom("b"), StringFrom("c")}
+	assertStringsEqual(t, first, wantFirst, "nulls first")
+
+	last := append([]String(nil), input...)
+	SortStrings(last, true)
+	wantLast := []String{StringFrom(""), StringFrom("a"), StringFrom("b"), StringFrom("c"), null, null}
+	assertStringsEqual(t, last, wantLast, "nulls last")
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		cmp  func() int
+		want int
+	}{
+		{"null strings", func() int { return NewString("", false).Compare(NewString("a", false)) }, 0},
+		{"null string first", func() int { return NewString("", false).Compare(StringFrom("")) }, -1},
+		{"strings", func() int { return StringFrom("b").Compare(StringFrom("a")) }, 1},
+		{"null int first", func() int { return IntFrom(math.MinInt64).Compare(NewInt(0, false)) }, 1},
+		{"ints", func() int { return IntFrom(-1).Compare(IntFrom(1)) }, -1},
+		{"floats", func() int { return FloatFrom(1.5).Compare(FloatFrom(1.5)) }, 0},
+		{"NaN first", func() int { return FloatFrom(math.NaN()).Compare(FloatFrom(math.Inf(-1))) }, -1},
+		{"NaN equal", func() int { return FloatFrom(math.NaN()).Compare(FloatFrom(math.NaN())) }, 0},
+		{"null float first", func() int { return NewFloat(0, false).Compare(FloatFrom(math.NaN())) }, -1},
+		{"false first", func() int { return BoolFrom(true).Compare(BoolFrom(false)) }, 1},
+		{"null bool first", func() int { return NewBool(false, false).Compare(BoolFrom(false)) }, -1},
+		{"times", func() int { return TimeFrom(timeValue1).Compare(TimeFrom(timeValue1.Add(time.Second))) }, -1},
+		{"same instant", func() int { return TimeFrom(timeValue1).Compare(TimeFrom(timeValue1.In(time.FixedZone("X", 3600)))) }, 0},
+		{"timestamps", func() int { return TimestampFrom(timestampValue).Compare(NewTimestamp(timestampValue, false)) }, 1},
+	}
+	for _, test := range tests {
+		if got := test.cmp(); got != test.want {
+			t.Errorf("%s: Compare() = %d, want %d", test.name, got, test.want)
+		}
+	}
+
+	if !NewInt(0, false).Less(IntFrom(0)) || IntFrom(0).Less(NewInt(0, false)) || IntFrom(1).Less(IntFrom(1)) {
+		t.Error("bad Less")
+	}
+}
+
+func assertStringsEqual(t *testing.T, got, want []String, from string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: bad length %d ≠ %d", from, len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("%s: element %d is %#v, want %#v", from, i, got[i], want[i])
+		}
+	}
+}
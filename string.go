@@ -6,14 +6,36 @@ package null
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
+// StringEmptyIsNull makes String decode a blank JSON string, and scan a blank SQL string, as null,
+// for APIs that use "" to mean null. By default a blank string is a valid String.
+// It is the decoding counterpart of StringFromZero.
+//
+// Deprecated: set Config.StringEmptyIsNull with SetConfig, which is safe to call while values are decoded.
+// This variable is ignored once SetConfig has been called.
+var StringEmptyIsNull = false
+
 // nullBytes is a JSON null literal
 var nullBytes = []byte("null")
 
+// utf8BOM is the UTF-8 encoded byte order mark.
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// trimJSON removes a leading byte order mark and surrounding white space from a JSON value.
+// encoding/json never passes either to UnmarshalJSON, but feeds from other sources
+// call it directly with them, and would otherwise fail to detect null.
+func trimJSON(data []byte) []byte {
+	return bytes.TrimSpace(bytes.TrimPrefix(bytes.TrimSpace(data), utf8BOM))
+}
+
 // String is a nullable string. It supports SQL and JSON serialization.
 // It will marshal to null if null. Blank string input will be considered null.
 type String struct {
@@ -21,16 +43,35 @@ type String struct {
 }
 
 // StringFrom creates a new String that will never be blank.
-func StringFrom(s string) String {
-	return NewString(s, true)
+// Options may make it null, see StringOption.
+func StringFrom(s string, opts ...StringOption) String {
+	str := NewString(s, true)
+	str.apply(opts)
+	return str
 }
 
 // StringFromPtr creates a new String that be null if s is nil.
-func StringFromPtr(s *string) String {
+// Options may make it null, see StringOption.
+func StringFromPtr(s *string, opts ...StringOption) String {
 	if s == nil {
 		return NewString("", false)
 	}
-	return NewString(*s, true)
+	return StringFrom(*s, opts...)
+}
+
+// StringFromResult creates a new String from the results of a function returning (string, error).
+// It returns a null String and err if err is not nil, otherwise a valid String.
+func StringFromResult(v string, err error) (String, error) {
+	if err != nil {
+		return NewString("", false), err
+	}
+	return NewString(v, true), nil
+}
+
+// StringFromZero creates a new String that will be null if s is a blank string.
+// It is the opposite of ValueOrZero.
+func StringFromZero(s string) String {
+	return NewString(s, s != "")
 }
 
 // ValueOrZero returns the inner value if valid, otherwise zero.
@@ -41,6 +82,14 @@ func (s String) ValueOrZero() string {
 	return s.String
 }
 
+// ValueOr returns the inner value if valid, otherwise def.
+func (s String) ValueOr(def string) string {
+	if !s.Valid {
+		return def
+	}
+	return s.String
+}
+
 // NewString creates a new String
 func NewString(s string, valid bool) String {
 	return String{
@@ -52,18 +101,24 @@ func NewString(s string, valid bool) String {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string and null input. Blank string input does not produce a null String.
+// It supports string and null input.
+// Blank string input does not produce a null String, unless StringEmptyIsNull is set.
 func (s *String) UnmarshalJSON(data []byte) error {
+	return reportError(s.unmarshalJSON(data))
+}
+
+func (s *String) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
 	if bytes.Equal(data, nullBytes) {
 		s.Valid = false
 		return nil
 	}
 
 	if err := json.Unmarshal(data, &s.String); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		return newUnmarshalError("String", fmt.Errorf("couldn't unmarshal JSON: %w", err))
 	}
 
-	s.Valid = true
+	s.Valid = !stringEmptyIsNull() || s.String != ""
 	return nil
 }
 
@@ -73,9 +128,31 @@ func (s String) MarshalJSON() ([]byte, error) {
 	if !s.Valid {
 		return []byte("null"), nil
 	}
+	if !needsJSONEscape(s.String) {
+		// fast path: the output is the string in quotes, as json.Marshal would produce
+		buf := make([]byte, 0, len(s.String)+2)
+		buf = append(buf, '"')
+		buf = append(buf, s.String...)
+		return append(buf, '"'), nil
+	}
 	return json.Marshal(s.String)
 }
 
+// needsJSONEscape returns false if json.Marshal would encode str as is, only adding quotes.
+// That is only certain for printable ASCII other than quotes, backslashes and the characters
+// json.Marshal escapes for HTML, so any other byte, including all non-ASCII, needs the slow path.
+func needsJSONEscape(str string) bool {
+	for i := 0; i < len(str); i++ {
+		switch c := str[i]; {
+		case c < 0x20 || c > 0x7e:
+			return true
+		case c == '"' || c == '\\' || c == '<' || c == '>' || c == '&':
+			return true
+		}
+	}
+	return false
+}
+
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string when this String is null.
 func (s String) MarshalText() ([]byte, error) {
@@ -85,6 +162,17 @@ func (s String) MarshalText() ([]byte, error) {
 	return []byte(s.String), nil
 }
 
+// MarshalXMLAttr implements xml.MarshalerAttr, so this String can be used for fields tagged with ",attr".
+// It omits the attribute if this String is null, instead of writing it with an empty value,
+// and otherwise writes the same value as MarshalText.
+func (s String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !s.Valid {
+		return xml.Attr{}, nil
+	}
+	text, err := s.MarshalText()
+	return xml.Attr{Name: name, Value: string(text)}, err
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null String if the input is a blank string.
 func (s *String) UnmarshalText(text []byte) error {
@@ -93,6 +181,32 @@ func (s *String) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// Scan implements the sql.Scanner interface.
+// A blank string scans to a null String if StringEmptyIsNull is set.
+func (s *String) Scan(value interface{}) error {
+	return reportError(s.scan(value))
+}
+
+func (s *String) scan(value interface{}) error {
+	if err := s.NullString.Scan(scanSource(value)); err != nil {
+		s.Valid = false
+		return newScanError("String", value, err)
+	}
+	if stringEmptyIsNull() && s.String == "" {
+		s.Valid = false
+	}
+	return nil
+}
+
+// ScanContext is like Scan, but returns ctx.Err() without scanning if ctx is already done.
+// It lets custom row loops stop before copying a large value.
+func (s *String) ScanContext(ctx context.Context, value interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.Scan(value)
+}
+
 // SetValid changes this String's value and also sets it to be non-null.
 func (s *String) SetValid(v string) {
 	s.String = v
@@ -107,12 +221,64 @@ func (s String) Ptr() *string {
 	return &s.String
 }
 
+// Unwrap returns this String as a sql.NullString, for code that needs the standard library type.
+func (s String) Unwrap() sql.NullString {
+	return s.NullString
+}
+
+// Reset makes this String null and clears its value, so a reused String doesn't keep previous data.
+func (s *String) Reset() {
+	*s = String{}
+}
+
 // IsZero returns true for null strings, for potential future omitempty support.
 func (s String) IsZero() bool {
 	return !s.Valid
 }
 
+// AsAny returns nil if this String is null, otherwise its value as string.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (s String) AsAny() interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.String
+}
+
 // Equal returns true if both strings have the same value or are both null.
 func (s String) Equal(other String) bool {
 	return s.Valid == other.Valid && (!s.Valid || s.String == other.String)
 }
+
+// Compare returns -1, 0 or +1 depending on whether s is less than, equal to or greater than other.
+// A null String sorts before all valid ones.
+func (s String) Compare(other String) int {
+	if c, ok := compareNull(s.Valid, other.Valid); ok {
+		return c
+	}
+	return strings.Compare(s.String, other.String)
+}
+
+// Less returns true if s sorts before other. A null String sorts before all valid ones.
+func (s String) Less(other String) bool {
+	return s.Compare(other) < 0
+}
+
+// ValueEqual returns true if both strings have the same value, treating null as a blank string.
+// Unlike Equal, a null String is ValueEqual to a valid blank one.
+func (s String) ValueEqual(other String) bool {
+	return s.ValueOrZero() == other.ValueOrZero()
+}
+
+// JSONSchema returns the JSON Schema of this String's JSON encoding: a string or null.
+func (s String) JSONSchema() map[string]interface{} {
+	return nullableSchema("string")
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this String as the Go code that creates it.
+func (s String) GoString() string {
+	if !s.Valid {
+		return `null.NewString("", false)`
+	}
+	return "null.StringFrom(" + strconv.Quote(s.String) + ")"
+}
@@ -0,0 +1,46 @@
+package null
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// StringOption configures a String created by StringFrom or StringFromPtr.
+// Options are applied in the order they are given.
+type StringOption func(*String)
+
+// WithTrim removes leading and trailing white space from the value.
+func WithTrim() StringOption {
+	return func(s *String) {
+		s.String = strings.TrimSpace(s.String)
+	}
+}
+
+// WithEmptyAsNull makes a blank value null.
+// Combine it with WithTrim, given first, to also treat white space as null.
+func WithEmptyAsNull() StringOption {
+	return func(s *String) {
+		if s.String == "" {
+			s.Valid = false
+		}
+	}
+}
+
+// WithMaxLen makes a value longer than n characters null.
+func WithMaxLen(n int) StringOption {
+	return func(s *String) {
+		if utf8.RuneCountInString(s.String) > n {
+			s.Valid = false
+		}
+	}
+}
+
+// apply runs opts on s, stopping once s is null.
+func (s *String) apply(opts []StringOption) {
+	for _, opt := range opts {
+		if !s.Valid {
+			return
+		}
+		opt(s)
+	}
+}
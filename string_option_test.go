@@ -0,0 +1,36 @@
+package null
+
+import "testing"
+
+func TestStringFromOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		opts []StringOption
+		want String
+	}{
+		{"no options", "  test ", nil, StringFrom("  test ")},
+		{"trim", "  test ", []StringOption{WithTrim()}, StringFrom("test")},
+		{"empty as null", "", []StringOption{WithEmptyAsNull()}, NewString("", false)},
+		{"trim then empty as null", "   ", []StringOption{WithTrim(), WithEmptyAsNull()}, NewString("", false)},
+		{"empty as null then trim", "   ", []StringOption{WithEmptyAsNull(), WithTrim()}, StringFrom("")},
+		{"trim then max len", " test ", []StringOption{WithTrim(), WithMaxLen(4)}, StringFrom("test")},
+		{"max len exceeded", " test ", []StringOption{WithMaxLen(4), WithTrim()}, NewString("", false)},
+		{"max len counts characters", "héllo", []StringOption{WithMaxLen(5)}, StringFrom("héllo")},
+	}
+	for _, test := range tests {
+		got := StringFrom(test.in, test.opts...)
+		if !got.Equal(test.want) {
+			t.Errorf("%s: StringFrom(%q) = %#v, want %#v", test.name, test.in, got, test.want)
+		}
+	}
+}
+
+func TestStringFromPtrOptions(t *testing.T) {
+	blank := "  "
+	assertNullStr(t, StringFromPtr(&blank, WithTrim(), WithEmptyAsNull()), "StringFromPtr() with options")
+	assertNullStr(t, StringFromPtr(nil, WithTrim()), "StringFromPtr(nil) with options")
+
+	str := " test "
+	assertStr(t, StringFromPtr(&str, WithTrim()), "StringFromPtr() trimmed")
+}
@@ -1,9 +1,15 @@
 package null
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
+	"math/big"
+	"reflect"
 	"testing"
+	"time"
 )
 
 var (
@@ -75,6 +81,57 @@ func TestUnmarshalString(t *testing.T) {
 	assertNullStr(t, invalid, "invalid json")
 }
 
+func TestStringEmptyIsNull(t *testing.T) {
+	defer func(prev bool) { StringEmptyIsNull = prev }(StringEmptyIsNull)
+
+	for _, emptyIsNull := range []bool{false, true} {
+		StringEmptyIsNull = emptyIsNull
+
+		var blank String
+		err := json.Unmarshal(blankStringJSON, &blank)
+		maybePanic(err)
+		if blank.Valid == emptyIsNull {
+			t.Errorf("StringEmptyIsNull=%v: bad blank json: %#v", emptyIsNull, blank)
+		}
+		var scanned String
+		err = scanned.Scan("")
+		maybePanic(err)
+		if scanned.Valid == emptyIsNull {
+			t.Errorf("StringEmptyIsNull=%v: bad blank scan: %#v", emptyIsNull, scanned)
+		}
+
+		var str String
+		err = json.Unmarshal([]byte(`"x"`), &str)
+		maybePanic(err)
+		if !str.Equal(StringFrom("x")) {
+			t.Errorf("StringEmptyIsNull=%v: bad json: %#v", emptyIsNull, str)
+		}
+		err = str.Scan("test")
+		maybePanic(err)
+		assertStr(t, str, "scanned string")
+
+		null := StringFrom("x")
+		err = json.Unmarshal(nullJSON, &null)
+		maybePanic(err)
+		assertNullStr(t, null, "null json")
+	}
+}
+
+func FuzzStringMarshalJSON(f *testing.F) {
+	for _, seed := range []string{"", "test", `a "b"`, `back\slash`, "tab\t", "\x00", "<a href>&", "héllo", "\u2028", "\xff"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, str string) {
+		got, err := StringFrom(str).MarshalJSON()
+		maybePanic(err)
+		want, err := json.Marshal(str)
+		maybePanic(err)
+		if string(got) != string(want) {
+			t.Errorf("MarshalJSON(%q) = %s, want %s", str, got, want)
+		}
+	})
+}
+
 func TestTextUnmarshalString(t *testing.T) {
 	var str String
 	err := str.UnmarshalText([]byte("test"))
@@ -169,6 +226,22 @@ func TestStringScan(t *testing.T) {
 	assertNullStr(t, null, "scanned null")
 }
 
+func TestStringScanContext(t *testing.T) {
+	var str String
+	err := str.ScanContext(context.Background(), "test")
+	maybePanic(err)
+	assertStr(t, str, "ScanContext()")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var canceled String
+	err = canceled.ScanContext(ctx, "test")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, not %v", err)
+	}
+	assertNullStr(t, canceled, "ScanContext() with canceled context")
+}
+
 func TestStringValueOrZero(t *testing.T) {
 	valid := NewString("test", true)
 	if valid.ValueOrZero() != "test" {
@@ -207,12 +280,341 @@ func TestStringEqual(t *testing.T) {
 	assertStringEqualIsFalse(t, str1, str2)
 }
 
+func TestStringValueOr(t *testing.T) {
+	valid := NewString("test", true)
+	if valid.ValueOr("default") != "test" {
+		t.Error("unexpected ValueOr", valid.ValueOr("default"))
+	}
+
+	invalid := NewString("test", false)
+	if invalid.ValueOr("default") != "default" {
+		t.Error("unexpected ValueOr", invalid.ValueOr("default"))
+	}
+}
+
+func TestStringValueEqual(t *testing.T) {
+	null := NewString("", false)
+	zero := NewString("", true)
+	valid := NewString("test", true)
+	if !null.ValueEqual(zero) || !zero.ValueEqual(null) {
+		t.Error("ValueEqual() of null and valid zero should be true")
+	}
+	if null.Equal(zero) {
+		t.Error("Equal() of null and valid zero should be false")
+	}
+	if !null.ValueEqual(null) || !valid.ValueEqual(valid) {
+		t.Error("ValueEqual() of the same values should be true")
+	}
+	if valid.ValueEqual(zero) || null.ValueEqual(valid) {
+		t.Error("ValueEqual() of different values should be false")
+	}
+}
+
+func TestUnmarshalJSONTrimsBOMAndSpace(t *testing.T) {
+	bom := "\xef\xbb\xbf"
+	for _, null := range []string{bom + "null", " null\n", bom + "\tnull "} {
+		s, i, f, b := StringFrom("x"), IntFrom(1), FloatFrom(1), BoolFrom(true)
+		tm, ts := TimeFrom(timeValue1), TimestampFrom(timestampValue)
+		for _, u := range []json.Unmarshaler{&s, &i, &f, &b, &tm, &ts} {
+			if err := u.UnmarshalJSON([]byte(null)); err != nil {
+				t.Errorf("%T: unexpected error for %q: %v", u, null, err)
+			}
+		}
+		assertNullStr(t, s, "padded null")
+		assertNullInt(t, i, "padded null")
+		assertNullFloat(t, f, "padded null")
+		assertNullBool(t, b, "padded null")
+		assertNullTime(t, tm, "padded null")
+		assertNullTimestamp(t, ts, "padded null")
+	}
+
+	var s String
+	err := s.UnmarshalJSON([]byte(bom + ` "test" `))
+	maybePanic(err)
+	assertStr(t, s, "padded string")
+
+	var i Int
+	err = i.UnmarshalJSON([]byte(bom + " 12345\r\n"))
+	maybePanic(err)
+	assertInt(t, i, "padded int")
+
+	var f Float
+	err = f.UnmarshalJSON([]byte(" 1.2345 "))
+	maybePanic(err)
+	assertFloat(t, f, "padded float")
+
+	var b Bool
+	err = b.UnmarshalJSON([]byte(bom + "true\n"))
+	maybePanic(err)
+	assertBool(t, b, "padded bool")
+
+	var tm Time
+	err = tm.UnmarshalJSON(append([]byte(bom+" "), timeJSON...))
+	maybePanic(err)
+	assertTime(t, tm, "padded time")
+
+	var ts Timestamp
+	err = ts.UnmarshalJSON([]byte(" 1356124881 "))
+	maybePanic(err)
+	assertTimestamp(t, ts, "padded timestamp")
+}
+
+func TestUnmarshalJSONTrimsAllTypes(t *testing.T) {
+	bom := "\xef\xbb\xbf"
+	for name, v := range fuzzTypes() {
+		for _, null := range []string{bom + "null", " null ", bom + "\tnull\r\n"} {
+			if err := v.UnmarshalJSON([]byte(null)); err != nil {
+				t.Errorf("%s: unexpected error for %q: %v", name, null, err)
+				continue
+			}
+			data, err := v.MarshalJSON()
+			maybePanic(err)
+			// most types marshal null as null, but some, such as Tagged, spell out their validity
+			want, err := fuzzTypes()[name].MarshalJSON()
+			maybePanic(err)
+			assertJSONEquals(t, data, string(want), name+" padded null")
+		}
+	}
+
+	tests := []struct {
+		v    jsonCodec
+		in   string
+		want string
+	}{
+		{new(Number), bom + " 1.50 ", "1.50"},
+		{new(Decimal), bom + "-12.50\n", "-12.50"},
+		{new(Int16), bom + "7", "7"},
+		{new(Uint), " 9 ", "9"},
+		{new(Percent), bom + "50", "50"},
+		{new(Month), bom + "3", "3"},
+		{new(Email), bom + ` "gopher@example.com" `, `"gopher@example.com"`},
+		{new(Ints), bom + " [1, null] ", "[1,null]"},
+	}
+	for _, test := range tests {
+		err := test.v.UnmarshalJSON([]byte(test.in))
+		maybePanic(err)
+		data, err := test.v.MarshalJSON()
+		maybePanic(err)
+		assertJSONEquals(t, data, test.want, test.in)
+	}
+}
+
+func TestFromResult(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	s, err := StringFromResult("test", nil)
+	maybePanic(err)
+	assertStr(t, s, "StringFromResult()")
+	s, err = StringFromResult("test", errFailed)
+	if err != errFailed {
+		t.Errorf("StringFromResult() error = %v, want %v", err, errFailed)
+	}
+	assertNullStr(t, s, "StringFromResult() with error")
+
+	i, err := IntFromResult(12345, nil)
+	maybePanic(err)
+	assertInt(t, i, "IntFromResult()")
+	i, err = IntFromResult(12345, errFailed)
+	if err != errFailed {
+		t.Errorf("IntFromResult() error = %v, want %v", err, errFailed)
+	}
+	assertNullInt(t, i, "IntFromResult() with error")
+
+	f, err := FloatFromResult(1.2345, nil)
+	maybePanic(err)
+	assertFloat(t, f, "FloatFromResult()")
+	f, err = FloatFromResult(1.2345, errFailed)
+	if err != errFailed {
+		t.Errorf("FloatFromResult() error = %v, want %v", err, errFailed)
+	}
+	assertNullFloat(t, f, "FloatFromResult() with error")
+
+	b, err := BoolFromResult(true, nil)
+	maybePanic(err)
+	assertBool(t, b, "BoolFromResult()")
+	b, err = BoolFromResult(true, errFailed)
+	if err != errFailed {
+		t.Errorf("BoolFromResult() error = %v, want %v", err, errFailed)
+	}
+	assertNullBool(t, b, "BoolFromResult() with error")
+
+	tm, err := TimeFromResult(timeValue1, nil)
+	maybePanic(err)
+	assertTime(t, tm, "TimeFromResult()")
+	tm, err = TimeFromResult(timeValue1, errFailed)
+	if err != errFailed {
+		t.Errorf("TimeFromResult() error = %v, want %v", err, errFailed)
+	}
+	assertNullTime(t, tm, "TimeFromResult() with error")
+
+	ts, err := TimestampFromResult(timestampValue, nil)
+	maybePanic(err)
+	assertTimestamp(t, ts, "TimestampFromResult()")
+	ts, err = TimestampFromResult(timestampValue, errFailed)
+	if err != errFailed {
+		t.Errorf("TimestampFromResult() error = %v, want %v", err, errFailed)
+	}
+	assertNullTimestamp(t, ts, "TimestampFromResult() with error")
+}
+
+func TestFromZero(t *testing.T) {
+	assertNullStr(t, StringFromZero(""), "StringFromZero(\"\")")
+	assertStr(t, StringFromZero("test"), "StringFromZero()")
+	assertNullInt(t, IntFromZero(0), "IntFromZero(0)")
+	assertInt(t, IntFromZero(12345), "IntFromZero()")
+	assertNullFloat(t, FloatFromZero(0), "FloatFromZero(0)")
+	assertFloat(t, FloatFromZero(1.2345), "FloatFromZero()")
+	assertNullBool(t, BoolFromZero(false), "BoolFromZero(false)")
+	assertBool(t, BoolFromZero(true), "BoolFromZero(true)")
+	assertNullTime(t, TimeFromZero(time.Time{}), "TimeFromZero(time.Time{})")
+	assertTime(t, TimeFromZero(timeValue1), "TimeFromZero()")
+	assertNullTimestamp(t, TimestampFromZero(time.Time{}), "TimestampFromZero(time.Time{})")
+	assertTimestamp(t, TimestampFromZero(timestampValue), "TimestampFromZero()")
+
+	for _, v := range []String{StringFrom("test"), NewString("", false)} {
+		if !StringFromZero(v.ValueOrZero()).Equal(v) {
+			t.Errorf("StringFromZero(ValueOrZero()) of %#v should round trip", v)
+		}
+	}
+}
+
+func TestAsAny(t *testing.T) {
+	type anyer interface{ AsAny() interface{} }
+	tests := []struct {
+		valid, null anyer
+		want        interface{}
+	}{
+		{StringFrom("test"), NewString("", false), "test"},
+		{IntFrom(12345), NewInt(0, false), int64(12345)},
+		{FloatFrom(1.2345), NewFloat(0, false), 1.2345},
+		{BoolFrom(true), NewBool(false, false), true},
+		{TimeFrom(timeValue1), NewTime(timeValue1, false), timeValue1},
+		{TimestampFrom(timestampValue), NewTimestamp(timestampValue, false), timestampValue},
+		{NumberFrom("1.50"), NewNumber("", false), json.Number("1.50")},
+		{PercentFrom(50), NewPercent(0, false), 50.0},
+		{MustSemver("1.2.3"), Semver{}, "1.2.3"},
+		{MustColor("#ff8000"), Color{}, "#ff8000"},
+		{FormBoolFrom(true), NewFormBool(false, false), true},
+		{EnumIntFrom(orderShipped), NewEnumInt(orderShipped, false), orderShipped},
+		{MonthFrom(time.March), NewMonth(time.March, false), time.March},
+		{Int32From(-5), NewInt32(0, false), int32(-5)},
+		{Int16From(-5), NewInt16(0, false), int16(-5)},
+		{UintFrom(5), NewUint(0, false), uint64(5)},
+		{SecretStringFrom("hunter2"), NewSecretString("", false), "***"},
+		{CIStringFrom("Active"), NewCIString("", false), "active"},
+		{TaggedFrom(42), Tagged[int]{}, 42},
+		{MustEmail("gopher@example.com"), Email{}, "gopher@example.com"},
+		{ByteSizeFrom(1024), ByteSize{}, int64(1024)},
+		{MustPhone("+1 415 555 2671"), Phone{}, "+14155552671"},
+		{EncryptedString{String: StringFrom("pii")}, EncryptedString{}, "pii"},
+		{MustDecimal("1.50"), Decimal{}, json.Number("1.50")},
+		{MustLang("en-us"), Lang{}, "en-US"},
+		{Base64From([]byte("hello")), Base64{}, "aGVsbG8="},
+	}
+	for _, test := range tests {
+		if got := test.valid.AsAny(); got != test.want {
+			t.Errorf("%#v.AsAny() = %#v (%T), want %#v (%T)", test.valid, got, got, test.want, test.want)
+		}
+		if got := test.null.AsAny(); got != nil {
+			t.Errorf("%#v.AsAny() = %#v, want nil", test.null, got)
+		}
+	}
+
+	ints := Ints{IntFrom(1), NewInt(0, false)}.AsAny()
+	if got, ok := ints.([]interface{}); !ok || len(got) != 2 || got[0] != int64(1) || got[1] != nil {
+		t.Errorf("bad Ints AsAny(): %#v", ints)
+	}
+	if got := Ints(nil).AsAny(); got != nil {
+		t.Errorf("nil Ints AsAny() = %#v, want nil", got)
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"s": StringFrom("test").AsAny(), "i": NewInt(0, false).AsAny()})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"i":null,"s":"test"}`, "AsAny map json")
+}
+
+func TestUnwrap(t *testing.T) {
+	tests := []struct {
+		got, want interface{}
+	}{
+		{StringFrom("test").Unwrap(), sql.NullString{String: "test", Valid: true}},
+		{NewString("", false).Unwrap(), sql.NullString{}},
+		{IntFrom(12345).Unwrap(), sql.NullInt64{Int64: 12345, Valid: true}},
+		{Int32From(-5).Unwrap(), sql.NullInt32{Int32: -5, Valid: true}},
+		{Int16From(7).Unwrap(), sql.NullInt16{Int16: 7, Valid: true}},
+		{FloatFrom(1.2345).Unwrap(), sql.NullFloat64{Float64: 1.2345, Valid: true}},
+		{PercentFrom(50).Unwrap(), sql.NullFloat64{Float64: 50, Valid: true}},
+		{BoolFrom(true).Unwrap(), sql.NullBool{Bool: true, Valid: true}},
+		{NewBool(false, false).Unwrap(), sql.NullBool{}},
+		{TimeFrom(timeValue1).Unwrap(), sql.NullTime{Time: timeValue1, Valid: true}},
+		{TimestampFrom(timestampValue).Unwrap(), sql.NullTime{Time: timestampValue, Valid: true}},
+		{NewTimestamp(timestampValue, false).Unwrap(), sql.NullTime{Time: timestampValue}},
+		{CIStringFrom("Active").Unwrap(), sql.NullString{String: "active", Valid: true}},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("Unwrap() = %#v, want %#v", test.got, test.want)
+		}
+	}
+}
+
+type xmlRecord struct {
+	XMLName xml.Name `xml:"record"`
+	ID      Int      `xml:"id,attr"`
+	Name    String   `xml:"name,attr"`
+	Active  Bool     `xml:"active,attr"`
+	Updated Time     `xml:"updated,attr"`
+}
+
+func TestMarshalXMLAttr(t *testing.T) {
+	full := xmlRecord{
+		ID:      IntFrom(12345),
+		Name:    StringFrom("test"),
+		Active:  BoolFrom(true),
+		Updated: TimeFrom(timeValue1),
+	}
+	data, err := xml.Marshal(full)
+	maybePanic(err)
+	assertJSONEquals(t, data, `<record id="12345" name="test" active="true" updated="`+timeString1+`"></record>`, "xml attrs")
+
+	var back xmlRecord
+	err = xml.Unmarshal(data, &back)
+	maybePanic(err)
+	assertInt(t, back.ID, "xml attr")
+	assertStr(t, back.Name, "xml attr")
+	assertBool(t, back.Active, "xml attr")
+	assertTime(t, back.Updated, "xml attr")
+
+	// null fields are left out instead of written as ""
+	data, err = xml.Marshal(xmlRecord{ID: IntFrom(12345)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `<record id="12345"></record>`, "null xml attrs")
+
+	back = xmlRecord{}
+	err = xml.Unmarshal(data, &back)
+	maybePanic(err)
+	assertInt(t, back.ID, "xml attr")
+	assertNullStr(t, back.Name, "absent xml attr")
+	assertNullBool(t, back.Active, "absent xml attr")
+	assertNullTime(t, back.Updated, "absent xml attr")
+}
+
 func maybePanic(err error) {
 	if err != nil {
 		panic(err)
 	}
 }
 
+func assertPanics(t *testing.T, f func(), from string) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s: expected panic", from)
+		}
+	}()
+	f()
+}
+
 func assertStr(t *testing.T, s String, from string) {
 	if s.String != "test" {
 		t.Errorf("bad %s string: %s ≠ %s\n", from, s.String, "test")
@@ -247,3 +649,55 @@ func assertStringEqualIsFalse(t *testing.T, a, b String) {
 		t.Errorf("Equal() of String{\"%v\", Valid:%t} and String{\"%v\", Valid:%t} should return false", a.String, a.Valid, b.String, b.Valid)
 	}
 }
+
+func TestReset(t *testing.T) {
+	type resetter interface {
+		Reset()
+		IsZero() bool
+	}
+	s, i, f, b := StringFrom("test"), IntFrom(12345), FloatFrom(1.2345), BoolFrom(true)
+	tm, ts, n, p := TimeFrom(timeValue1), TimestampFrom(timestampValue), NumberFrom("1.50"), PercentFrom(50)
+	sv, c, e, m := MustSemver("1.2.3"), MustColor("#ff8000"), EnumIntFrom(orderShipped), MonthFrom(time.March)
+	i32, i16, u, sec := Int32From(-5), Int16From(-5), UintFrom(5), SecretStringFrom("hunter2")
+	em, ph, d, r := MustEmail("gopher@example.com"), MustPhone("+1 415 555 2671"), MustDecimal("1.50"), RatFrom(big.NewRat(1, 2))
+	l, b64, ci, fb := MustLang("en-us"), Base64From([]byte("hello")), CIStringFrom("Active"), FormBoolFrom(true)
+	tg, bs := TaggedFrom(StringFrom("test")), ByteSizeFrom(1024)
+	tests := []resetter{
+		&s, &i, &f, &b, &tm, &ts, &n, &p, &sv, &c, &e, &m,
+		&i32, &i16, &u, &sec, &em, &ph, &d, &r, &l, &b64, &ci, &fb, &tg, &bs,
+	}
+	for _, v := range tests {
+		if v.IsZero() {
+			t.Fatalf("%#v: IsZero() before Reset", v)
+		}
+		v.Reset()
+		if !reflect.ValueOf(v).Elem().IsZero() {
+			t.Errorf("%T not cleared by Reset: %#v", v, v)
+		}
+	}
+	if r.Rat != nil {
+		t.Error("Rat still referenced after Reset")
+	}
+
+	ints := Ints{IntFrom(1), IntFrom(2)}
+	ints.Reset()
+	if ints != nil {
+		t.Errorf("Ints after Reset = %#v, want nil", ints)
+	}
+
+	// types with behavior keep it, only their value is cleared
+	vs := NewValidatedString(func(s string) error {
+		if s == "" {
+			return errors.New("empty")
+		}
+		return nil
+	})
+	maybePanic(vs.SetValid("test"))
+	vs.Reset()
+	if vs.Valid || vs.String.String != "" {
+		t.Errorf("ValidatedString not cleared by Reset: %#v", vs)
+	}
+	if vs.SetValid("") == nil {
+		t.Error("ValidatedString lost its validation after Reset")
+	}
+}
@@ -0,0 +1,236 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Tagged is a nullable value of any type, held in Val, that marshals to JSON with its validity spelled out,
+// as {"value":42,"valid":true} or {"value":null,"valid":false}, for consumers with a strict schema.
+// UnmarshalJSON accepts the same objects, and null, and rejects anything else.
+type Tagged[T any] struct {
+	Val   T
+	Valid bool
+}
+
+// NewTagged creates a new Tagged
+func NewTagged[T any](v T, valid bool) Tagged[T] {
+	return Tagged[T]{Val: v, Valid: valid}
+}
+
+// TaggedFrom creates a new Tagged that will always be valid.
+func TaggedFrom[T any](v T) Tagged[T] {
+	return NewTagged(v, true)
+}
+
+// taggedJSON is the object form of a Tagged.
+type taggedJSON struct {
+	Value json.RawMessage `json:"value"`
+	Valid *bool           `json:"valid"`
+}
+
+// Scan implements the sql.Scanner interface.
+// It uses T's Scan method if it has one, otherwise the value must already be a T.
+func (t *Tagged[T]) Scan(value interface{}) error {
+	return reportError(t.scan(value))
+}
+
+func (t *Tagged[T]) scan(value interface{}) error {
+	src := scanSource(value)
+	if src == nil {
+		*t = Tagged[T]{}
+		return nil
+	}
+	var v T
+	if ok, err := scanUnreported(value, &v); ok {
+		if err != nil {
+			*t = Tagged[T]{}
+			return retypeError("Tagged", err)
+		}
+	} else if v, ok = src.(T); !ok {
+		*t = Tagged[T]{}
+		return newScanError("Tagged", value, errUnsupportedScanType)
+	}
+	*t = TaggedFrom(v)
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It uses T's Value method if it has one, otherwise it passes the value to the driver as is.
+func (t Tagged[T]) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(t.Val)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null and objects with exactly the keys "value" and "valid".
+// A valid object must hold a non-null value, and a null one must hold null.
+func (t *Tagged[T]) UnmarshalJSON(data []byte) error {
+	return reportError(t.unmarshalJSON(data))
+}
+
+func (t *Tagged[T]) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
+	if bytes.Equal(data, nullBytes) {
+		*t = Tagged[T]{}
+		return nil
+	}
+
+	var obj taggedJSON
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&obj); err != nil {
+		*t = Tagged[T]{}
+		return newUnmarshalError("Tagged", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	if err := obj.check(); err != nil {
+		*t = Tagged[T]{}
+		return newUnmarshalError("Tagged", err)
+	}
+	if !*obj.Valid {
+		*t = Tagged[T]{}
+		return nil
+	}
+
+	var v T
+	if err := unmarshalUnreported(obj.Value, &v); err != nil {
+		*t = Tagged[T]{}
+		return newUnmarshalError("Tagged", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	*t = TaggedFrom(v)
+	return nil
+}
+
+// check returns an error if the object is missing a key or its value doesn't match its validity.
+func (obj taggedJSON) check() error {
+	if obj.Valid == nil {
+		return errors.New(`missing "valid" key`)
+	}
+	if obj.Value == nil {
+		return errors.New(`missing "value" key`)
+	}
+	isNull := bytes.Equal(trimJSON(obj.Value), nullBytes)
+	if *obj.Valid && isNull {
+		return errors.New(`"value" is null but "valid" is true`)
+	}
+	if !*obj.Valid && !isNull {
+		return errors.New(`"value" is not null but "valid" is false`)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It encodes an object holding the value, or null if this Tagged is null, and the validity.
+func (t Tagged[T]) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte(`{"value":null,"valid":false}`), nil
+	}
+	value, err := json.Marshal(t.Val)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte(`{"value":`), value...), `,"valid":true}`...), nil
+}
+
+// SetValid changes this Tagged's value and also sets it to be non-null.
+func (t *Tagged[T]) SetValid(v T) {
+	t.Val = v
+	t.Valid = true
+}
+
+// Ptr returns a pointer to this Tagged's value, or a nil pointer if this Tagged is null.
+func (t Tagged[T]) Ptr() *T {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Val
+}
+
+// Reset makes this Tagged null and clears its value, so a reused Tagged doesn't keep previous data.
+func (t *Tagged[T]) Reset() {
+	*t = Tagged[T]{}
+}
+
+// IsZero returns true for null Taggeds.
+// A non-null Tagged holding a zero value will not be considered zero.
+func (t Tagged[T]) IsZero() bool {
+	return !t.Valid
+}
+
+// AsAny returns nil if this Tagged is null, otherwise its value.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (t Tagged[T]) AsAny() interface{} {
+	if !t.Valid {
+		return nil
+	}
+	return t.Val
+}
+
+// Equal returns true if both Taggeds hold equal values or are both null.
+// Values are compared with T's Equal method if it has one, otherwise with reflect.DeepEqual.
+func (t Tagged[T]) Equal(other Tagged[T]) bool {
+	if t.Valid != other.Valid {
+		return false
+	}
+	if !t.Valid {
+		return true
+	}
+	if eq, ok := interface{}(t.Val).(interface{ Equal(T) bool }); ok {
+		return eq.Equal(other.Val)
+	}
+	return reflect.DeepEqual(t.Val, other.Val)
+}
+
+// JSONSchema returns the JSON Schema of this Tagged's JSON encoding: an object holding the value and validity, or null.
+// The value's schema comes from T's JSONSchema method if it has one, otherwise any value is allowed.
+func (t Tagged[T]) JSONSchema() map[string]interface{} {
+	value := map[string]interface{}{}
+	var v T
+	if s, ok := interface{}(v).(interface{ JSONSchema() map[string]interface{} }); ok {
+		value = s.JSONSchema()
+	}
+	schema := nullableSchema("object")
+	schema["properties"] = map[string]interface{}{
+		"value": value,
+		"valid": map[string]interface{}{"type": "boolean"},
+	}
+	schema["required"] = []string{"value", "valid"}
+	schema["additionalProperties"] = false
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Tagged as the Go code that creates it.
+func (t Tagged[T]) GoString() string {
+	if !t.Valid {
+		return fmt.Sprintf("null.Tagged[%T]{}", t.Val)
+	}
+	return fmt.Sprintf("null.Tagged[%T]{Val: %#v, Valid: true}", t.Val, t.Val)
+}
+
+// Pair holds the values combined by Zip.
+type Pair[A, B any] struct {
+	A A
+	B B
+}
+
+// Zip combines a and b into a Tagged Pair, which is valid only if both are valid.
+func Zip[A, B any](a Tagged[A], b Tagged[B]) Tagged[Pair[A, B]] {
+	if !a.Valid || !b.Valid {
+		return Tagged[Pair[A, B]]{}
+	}
+	return TaggedFrom(Pair[A, B]{A: a.Val, B: b.Val})
+}
+
+// Unzip is the inverse of Zip. If p is null, both results are null.
+func Unzip[A, B any](p Tagged[Pair[A, B]]) (Tagged[A], Tagged[B]) {
+	if !p.Valid {
+		return Tagged[A]{}, Tagged[B]{}
+	}
+	return TaggedFrom(p.Val.A), TaggedFrom(p.Val.B)
+}
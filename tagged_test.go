@@ -0,0 +1,124 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTaggedJSON(t *testing.T) {
+	tests := []struct {
+		in   Tagged[int]
+		want string
+	}{
+		{TaggedFrom(42), `{"value":42,"valid":true}`},
+		{TaggedFrom(0), `{"value":0,"valid":true}`},
+		{NewTagged(42, false), `{"value":null,"valid":false}`},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(test.in)
+		maybePanic(err)
+		assertJSONEquals(t, data, test.want, "tagged json marshal")
+
+		var round Tagged[int]
+		err = json.Unmarshal(data, &round)
+		maybePanic(err)
+		if !round.Equal(test.in) {
+			t.Errorf("bad round trip of %s: %#v", data, round)
+		}
+	}
+
+	var null Tagged[int]
+	err := json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json should be null")
+	}
+
+	nested := TaggedFrom(StringFrom("test"))
+	data, err := json.Marshal(nested)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"value":"test","valid":true}`, "nested tagged json marshal")
+}
+
+func TestTaggedJSONInvalid(t *testing.T) {
+	for _, bad := range []string{
+		`42`, `"42"`, `{}`, `{"value":42}`, `{"valid":true}`,
+		`{"value":null,"valid":true}`, `{"value":42,"valid":false}`,
+		`{"value":42,"valid":true,"extra":1}`, `{"value":"42","valid":true}`, `{"value":42,"valid":"true"}`,
+	} {
+		v := TaggedFrom(1)
+		err := json.Unmarshal([]byte(bad), &v)
+		if err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+		if v.Valid {
+			t.Errorf("%s should be null", bad)
+		}
+	}
+}
+
+func TestTaggedScanValue(t *testing.T) {
+	var i Tagged[int64]
+	err := i.Scan(int64(42))
+	maybePanic(err)
+	if !i.Equal(TaggedFrom(int64(42))) {
+		t.Errorf("bad scan: %#v", i)
+	}
+	v, err := i.Value()
+	maybePanic(err)
+	if v != int64(42) {
+		t.Errorf("bad value: %#v", v)
+	}
+
+	err = i.Scan(nil)
+	maybePanic(err)
+	if i.Valid {
+		t.Error("scanned nil should be null")
+	}
+	v, err = i.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null value = %#v, want nil", v)
+	}
+
+	if err := i.Scan("abc"); err == nil {
+		t.Error("expected error scanning a string into Tagged[int64]")
+	}
+
+	// types that implement sql.Scanner scan themselves
+	var s Tagged[String]
+	err = s.Scan("test")
+	maybePanic(err)
+	if !s.Valid || s.Val.String != "test" {
+		t.Errorf("bad scan of Tagged[String]: %#v", s)
+	}
+}
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		a     Tagged[int]
+		b     Tagged[string]
+		valid bool
+	}{
+		{TaggedFrom(1), TaggedFrom("one"), true},
+		{TaggedFrom(1), Tagged[string]{}, false},
+		{Tagged[int]{}, TaggedFrom("one"), false},
+		{Tagged[int]{}, Tagged[string]{}, false},
+	}
+	for _, test := range tests {
+		zipped := Zip(test.a, test.b)
+		if zipped.Valid != test.valid {
+			t.Errorf("Zip(%#v, %#v).Valid = %v, want %v", test.a, test.b, zipped.Valid, test.valid)
+		}
+		a, b := Unzip(zipped)
+		if !test.valid {
+			if a.Valid || b.Valid {
+				t.Errorf("Unzip of null pair should be null: %#v, %#v", a, b)
+			}
+			continue
+		}
+		if !a.Equal(test.a) || !b.Equal(test.b) {
+			t.Errorf("Unzip(Zip(%#v, %#v)) = %#v, %#v", test.a, test.b, a, b)
+		}
+	}
+}
@@ -5,16 +5,47 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"time"
 )
 
+// Now returns the current time. Every function in this package that depends on the current time,
+// such as TimeNow and Timestamp.Since, calls it instead of time.Now, so tests can replace it to freeze time.
+var Now = time.Now
+
 // Time is a nullable time.Time. It supports SQL and JSON serialization.
 // It will marshal to null if null.
 type Time struct {
 	sql.NullTime
 }
 
+// Scan implements the sql.Scanner interface.
+// Besides time.Time, it parses string and []byte input with the layouts set by SetTimeLayouts,
+// RFC 3339 by default, for drivers that return times as text.
+func (t *Time) Scan(value interface{}) error {
+	return reportError(t.scan(value))
+}
+
+func (t *Time) scan(value interface{}) error {
+	var err error
+	switch v := scanSource(value).(type) {
+	case string:
+		t.Time, err = parseTimeLayouts(v)
+		t.Valid = true
+	case []byte:
+		t.Time, err = parseTimeLayouts(string(v))
+		t.Valid = true
+	default:
+		err = t.NullTime.Scan(v)
+	}
+	if err != nil {
+		t.Valid = false
+		return newScanError("Time", value, err)
+	}
+	return nil
+}
+
 // Value implements the driver Valuer interface.
 func (t Time) Value() (driver.Value, error) {
 	if !t.Valid {
@@ -24,26 +55,47 @@ func (t Time) Value() (driver.Value, error) {
 }
 
 // NewTime creates a new Time.
-func NewTime(t time.Time, valid bool) Time {
-	return Time{
-		NullTime: sql.NullTime{
-			Time:  t,
-			Valid: valid,
-		},
+// Options may change the time or make it null, see TimeOption.
+func NewTime(t time.Time, valid bool, opts ...TimeOption) Time {
+	nt := sql.NullTime{
+		Time:  t,
+		Valid: valid,
 	}
+	applyTimeOptions(&nt, opts)
+	return Time{NullTime: nt}
 }
 
-// TimeFrom creates a new Time that will always be valid.
-func TimeFrom(t time.Time) Time {
-	return NewTime(t, true)
+// TimeFrom creates a new Time that will always be valid, unless an option makes it null.
+func TimeFrom(t time.Time, opts ...TimeOption) Time {
+	return NewTime(t, true, opts...)
 }
 
 // TimeFromPtr creates a new Time that will be null if t is nil.
-func TimeFromPtr(t *time.Time) Time {
+func TimeFromPtr(t *time.Time, opts ...TimeOption) Time {
 	if t == nil {
 		return NewTime(time.Time{}, false)
 	}
-	return NewTime(*t, true)
+	return NewTime(*t, true, opts...)
+}
+
+// TimeFromResult creates a new Time from the results of a function returning (time.Time, error).
+// It returns a null Time and err if err is not nil, otherwise a valid Time.
+func TimeFromResult(v time.Time, err error) (Time, error) {
+	if err != nil {
+		return NewTime(time.Time{}, false), err
+	}
+	return NewTime(v, true), nil
+}
+
+// TimeNow creates a new Time holding the current time, that will always be valid unless an option makes it null.
+func TimeNow(opts ...TimeOption) Time {
+	return TimeFrom(Now(), opts...)
+}
+
+// TimeFromZero creates a new Time that will be null if t is the zero time.
+// It is the opposite of ValueOrZero.
+func TimeFromZero(t time.Time) Time {
+	return NewTime(t, !t.IsZero())
 }
 
 // ValueOrZero returns the inner value if valid, otherwise zero.
@@ -54,27 +106,47 @@ func (t Time) ValueOrZero() time.Time {
 	return t.Time
 }
 
+// ValueOr returns the inner value if valid, otherwise def.
+func (t Time) ValueOr(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+	return t.Time
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this time is null.
+// Any monotonic clock reading is stripped first, so the output only depends on the wall clock time.
 func (t Time) MarshalJSON() ([]byte, error) {
 	if !t.Valid {
 		return []byte("null"), nil
 	}
-	return t.Time.MarshalJSON()
+	return t.Time.Round(0).MarshalJSON()
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string and null input.
+// It supports string and null input. Strings are parsed with the layouts set by SetTimeLayouts.
 func (t *Time) UnmarshalJSON(data []byte) error {
+	return reportError(t.unmarshalJSON(data))
+}
+
+func (t *Time) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
 	if bytes.Equal(data, nullBytes) {
 		t.Valid = false
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &t.Time); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return newUnmarshalError("Time", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	ti, err := parseTimeLayouts(str)
+	if err != nil {
+		return newUnmarshalError("Time", fmt.Errorf("couldn't unmarshal JSON: %w", err))
 	}
 
+	t.Time = ti
 	t.Valid = true
 	return nil
 }
@@ -88,23 +160,55 @@ func (t Time) MarshalText() ([]byte, error) {
 	return t.Time.MarshalText()
 }
 
+// MarshalXMLAttr implements xml.MarshalerAttr, so this Time can be used for fields tagged with ",attr".
+// It omits the attribute if this Time is null, instead of writing it with an empty value,
+// and otherwise writes the same value as MarshalText.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !t.Valid {
+		return xml.Attr{}, nil
+	}
+	text, err := t.MarshalText()
+	return xml.Attr{Name: name, Value: string(text)}, err
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It has backwards compatibility with v3 in that the string "null" is considered equivalent to an empty string
 // and unmarshaling will succeed. This may be removed in a future version.
+// Other text is parsed with the layouts set by SetTimeLayouts.
 func (t *Time) UnmarshalText(text []byte) error {
+	return reportError(t.unmarshalText(text))
+}
+
+func (t *Time) unmarshalText(text []byte) error {
 	str := string(text)
 	// allowing "null" is for backwards compatibility with v3
 	if str == "" || str == "null" {
 		t.Valid = false
 		return nil
 	}
-	if err := t.Time.UnmarshalText(text); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	ti, err := parseTimeLayouts(str)
+	if err != nil {
+		return newUnmarshalError("Time", fmt.Errorf("couldn't unmarshal text: %w", err))
 	}
+	t.Time = ti
 	t.Valid = true
 	return nil
 }
 
+// Format returns the time formatted according to layout, or a blank string if this Time is null.
+// It's meant for display only and doesn't affect marshaling.
+func (t Time) Format(layout string) string {
+	return t.FormatOr(layout, "")
+}
+
+// FormatOr returns the time formatted according to layout, or fallback if this Time is null.
+func (t Time) FormatOr(layout, fallback string) string {
+	if !t.Valid {
+		return fallback
+	}
+	return t.Time.Format(layout)
+}
+
 // SetValid changes this Time's value and sets it to be non-null.
 func (t *Time) SetValid(v time.Time) {
 	t.Time = v
@@ -119,12 +223,51 @@ func (t Time) Ptr() *time.Time {
 	return &t.Time
 }
 
+// Unwrap returns this Time as a sql.NullTime, for code that needs the standard library type.
+func (t Time) Unwrap() sql.NullTime {
+	return t.NullTime
+}
+
+// Reset makes this Time null and clears its value, so a reused Time doesn't keep previous data.
+func (t *Time) Reset() {
+	*t = Time{}
+}
+
 // IsZero returns true for invalid Times, hopefully for future omitempty support.
 // A non-null Time with a zero value will not be considered zero.
 func (t Time) IsZero() bool {
 	return !t.Valid
 }
 
+// AsAny returns nil if this Time is null, otherwise its value as time.Time.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (t Time) AsAny() interface{} {
+	if !t.Valid {
+		return nil
+	}
+	return t.Time
+}
+
+// Timestamp converts this Time to a Timestamp with the same time.Time and validity.
+// The full time is kept, but Timestamp marshals to whole seconds since the epoch,
+// so fractional seconds and the location are lost once the result is marshaled. See Timestamp.HasSubSecond.
+func (t Time) Timestamp() Timestamp {
+	return Timestamp{NullTime: t.NullTime}
+}
+
+// IsNull returns true for invalid Times. It is the same as IsZero,
+// named so it can't be mistaken for IsZeroTime.
+func (t Time) IsNull() bool {
+	return !t.Valid
+}
+
+// IsZeroTime returns true for valid Times holding the zero time.Time.
+// Null Times are not zero times. The omitzero option of encoding/json calls IsZero,
+// so it omits null Times but still encodes a valid zero time.
+func (t Time) IsZeroTime() bool {
+	return t.Valid && t.Time.IsZero()
+}
+
 // Equal returns true if both Time objects encode the same time or are both null.
 // Two times can be equal even if they are in different locations.
 // For example, 6:00 +0200 CEST and 4:00 UTC are Equal.
@@ -132,9 +275,44 @@ func (t Time) Equal(other Time) bool {
 	return t.Valid == other.Valid && (!t.Valid || t.Time.Equal(other.Time))
 }
 
+// Compare returns -1, 0 or +1 depending on whether t is less than, equal to or greater than other.
+// A null Time sorts before all valid ones.
+func (t Time) Compare(other Time) int {
+	if c, ok := compareNull(t.Valid, other.Valid); ok {
+		return c
+	}
+	return compareTime(t.Time, other.Time)
+}
+
+// Less returns true if t sorts before other. A null Time sorts before all valid ones.
+func (t Time) Less(other Time) bool {
+	return t.Compare(other) < 0
+}
+
+// ValueEqual returns true if both Time objects encode the same time, treating null as the zero time.
+// Unlike Equal, a null Time is ValueEqual to a valid zero time.
+func (t Time) ValueEqual(other Time) bool {
+	return t.ValueOrZero().Equal(other.ValueOrZero())
+}
+
 // ExactEqual returns true if both Time objects are equal or both null.
 // ExactEqual returns false for times that are in different locations or
 // have a different monotonic clock reading.
 func (t Time) ExactEqual(other Time) bool {
 	return t.Valid == other.Valid && (!t.Valid || t.Time == other.Time)
 }
+
+// JSONSchema returns the JSON Schema of this Time's JSON encoding: an RFC 3339 date-time string or null.
+func (t Time) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("string")
+	schema["format"] = "date-time"
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Time as the Go code that creates it.
+func (t Time) GoString() string {
+	if !t.Valid {
+		return `null.NewTime(time.Time{}, false)`
+	}
+	return "null.TimeFrom(" + goTime(t.Time) + ")"
+}
@@ -0,0 +1,45 @@
+package null
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TimeOption configures a Time or Timestamp created by its constructors.
+// Options are applied in the order they are given.
+type TimeOption func(*sql.NullTime)
+
+// WithPrecision truncates the time to a multiple of d, such as time.Millisecond.
+// This matches databases that store less than nanosecond precision.
+func WithPrecision(d time.Duration) TimeOption {
+	return func(t *sql.NullTime) {
+		t.Time = t.Time.Truncate(d)
+	}
+}
+
+// WithLocation converts the time to loc.
+func WithLocation(loc *time.Location) TimeOption {
+	return func(t *sql.NullTime) {
+		t.Time = t.Time.In(loc)
+	}
+}
+
+// WithBounds makes a time before min or after max null.
+// A zero min or max leaves that side unbounded.
+func WithBounds(min, max time.Time) TimeOption {
+	return func(t *sql.NullTime) {
+		if (!min.IsZero() && t.Time.Before(min)) || (!max.IsZero() && t.Time.After(max)) {
+			t.Valid = false
+		}
+	}
+}
+
+// applyTimeOptions runs opts on t, stopping once t is null.
+func applyTimeOptions(t *sql.NullTime, opts []TimeOption) {
+	for _, opt := range opts {
+		if !t.Valid {
+			return
+		}
+		opt(t)
+	}
+}
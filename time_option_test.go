@@ -0,0 +1,50 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimestampFromOptions(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	in := time.Date(2020, 5, 17, 10, 30, 0, 123456789, time.UTC)
+	min := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ts := TimestampFrom(in, WithPrecision(time.Millisecond), WithLocation(loc), WithBounds(min, max))
+	if !ts.Valid {
+		t.Fatal("timestamp within bounds should be valid")
+	}
+	if want := time.Date(2020, 5, 17, 12, 30, 0, 123000000, loc); !ts.Time.Equal(want) || ts.Time.Location() != loc {
+		t.Errorf("TimestampFrom() = %v, want %v", ts.Time, want)
+	}
+
+	out := TimestampFrom(in, WithLocation(loc), WithBounds(max, time.Time{}))
+	assertNullTimestamp(t, out, "TimestampFrom() before min")
+
+	out = TimestampFrom(in, WithBounds(time.Time{}, min), WithLocation(loc))
+	assertNullTimestamp(t, out, "TimestampFrom() after max")
+
+	assertNullTimestamp(t, TimestampFromPtr(nil, WithLocation(loc)), "TimestampFromPtr(nil)")
+	assertNullTimestamp(t, NewTimestamp(in, false, WithPrecision(time.Second)), "NewTimestamp() null")
+
+	plain := TimestampFrom(in)
+	if !plain.Time.Equal(in) {
+		t.Errorf("TimestampFrom() without options changed the time to %v", plain.Time)
+	}
+}
+
+func TestTimeFromOptions(t *testing.T) {
+	in := time.Date(2020, 5, 17, 10, 30, 15, 999999999, time.UTC)
+
+	tm := TimeFromPtr(&in, WithPrecision(time.Second), WithLocation(time.UTC))
+	if want := time.Date(2020, 5, 17, 10, 30, 15, 0, time.UTC); !tm.Valid || !tm.Time.Equal(want) {
+		t.Errorf("TimeFromPtr() = %v, want %v", tm.Time, want)
+	}
+
+	bound := time.Date(2020, 5, 17, 10, 30, 15, 0, time.UTC)
+	assertNullTime(t, TimeFrom(in, WithBounds(time.Time{}, bound)), "TimeFrom() after max")
+	if tm := TimeFrom(in, WithPrecision(time.Second), WithBounds(time.Time{}, bound)); !tm.Valid {
+		t.Error("TimeFrom() truncated onto max should be valid")
+	}
+}
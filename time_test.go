@@ -106,6 +106,35 @@ func TestMarshalTime(t *testing.T) {
 	assertJSONEquals(t, data, string(nullJSON), "null json marshal")
 }
 
+func TestMarshalTimeMonotonic(t *testing.T) {
+	now := TimeFrom(time.Now())
+	data, err := json.Marshal(now)
+	maybePanic(err)
+
+	var back Time
+	err = json.Unmarshal(data, &back)
+	maybePanic(err)
+	if !back.Time.Equal(now.Time) {
+		t.Errorf("reparsed time %v ≠ %v", back.Time, now.Time)
+	}
+	again, err := json.Marshal(back)
+	maybePanic(err)
+	assertJSONEquals(t, again, string(data), "re-marshaled time")
+}
+
+func TestTimeNow(t *testing.T) {
+	defer func(prev func() time.Time) { Now = prev }(Now)
+	Now = func() time.Time { return timeValue1 }
+
+	assertTime(t, TimeNow(), "TimeNow()")
+	if ts := TimestampNow(); !ts.Valid || !ts.Time.Equal(timeValue1) {
+		t.Errorf("bad TimestampNow(): %v", ts.Time)
+	}
+	if ts := TimestampNow(WithPrecision(time.Hour)); !ts.Valid || !ts.Time.Equal(timeValue1.Truncate(time.Hour)) {
+		t.Errorf("TimestampNow() should apply options: %v", ts.Time)
+	}
+}
+
 func TestTimeFrom(t *testing.T) {
 	ti := TimeFrom(timeValue1)
 	assertTime(t, ti, "TimeFrom() time.Time")
@@ -166,6 +195,33 @@ func TestTimeScanValue(t *testing.T) {
 	}
 }
 
+func TestTimeScanString(t *testing.T) {
+	var ti Time
+	err := ti.Scan(timeString1)
+	maybePanic(err)
+	assertTime(t, ti, "scanned RFC 3339 string")
+
+	err = ti.Scan([]byte(timeString2))
+	maybePanic(err)
+	if !ti.Valid || !ti.Time.Equal(timeValue1) {
+		t.Errorf("bad scan of RFC 3339 bytes: %v", ti.Time)
+	}
+
+	bad := TimeFrom(timeValue1)
+	if err := bad.Scan("2012-13-45T25:61:00Z"); err == nil {
+		t.Error("expected error scanning a malformed time")
+	}
+	assertNullTime(t, bad, "malformed time scan")
+
+	defer SetTimeLayouts(nil)
+	SetTimeLayouts([]string{"02/01/2006"})
+	err = ti.Scan("21/12/2012")
+	maybePanic(err)
+	if want := time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC); !ti.Valid || !ti.Time.Equal(want) {
+		t.Errorf("bad scan with custom layout: %v", ti.Time)
+	}
+}
+
 func TestTimeValueOrZero(t *testing.T) {
 	valid := TimeFrom(timeValue1)
 	if valid.ValueOrZero() != valid.Time || valid.ValueOrZero().IsZero() {
@@ -256,6 +312,78 @@ func TestTimeExactEqual(t *testing.T) {
 	assertTimeExactEqualIsFalse(t, t1, t2)
 }
 
+func TestTimeFormat(t *testing.T) {
+	ti := TimeFrom(timeValue1.UTC())
+	if got := ti.Format("2006-01-02 15:04"); got != "2012-12-21 21:21" {
+		t.Errorf("Format() = %q, want %q", got, "2012-12-21 21:21")
+	}
+	if got := ti.FormatOr("2006-01-02", "n/a"); got != "2012-12-21" {
+		t.Errorf("FormatOr() = %q, want %q", got, "2012-12-21")
+	}
+
+	null := NewTime(timeValue1, false)
+	if got := null.Format("2006-01-02"); got != "" {
+		t.Errorf("Format() of null = %q, want blank", got)
+	}
+	if got := null.FormatOr("2006-01-02", "n/a"); got != "n/a" {
+		t.Errorf("FormatOr() of null = %q, want %q", got, "n/a")
+	}
+}
+
+func TestTimeValueOr(t *testing.T) {
+	valid := NewTime(timeValue1, true)
+	if !valid.ValueOr(timeValue3).Equal(timeValue1) {
+		t.Error("unexpected ValueOr", valid.ValueOr(timeValue3))
+	}
+
+	invalid := NewTime(timeValue1, false)
+	if !invalid.ValueOr(timeValue3).Equal(timeValue3) {
+		t.Error("unexpected ValueOr", invalid.ValueOr(timeValue3))
+	}
+}
+
+func TestTimeValueEqual(t *testing.T) {
+	null := NewTime(timeValue1, false)
+	zero := NewTime(time.Time{}, true)
+	valid := NewTime(timeValue1, true)
+	if !null.ValueEqual(zero) || !zero.ValueEqual(null) {
+		t.Error("ValueEqual() of null and valid zero should be true")
+	}
+	if null.Equal(zero) {
+		t.Error("Equal() of null and valid zero should be false")
+	}
+	if !null.ValueEqual(null) || !valid.ValueEqual(valid) {
+		t.Error("ValueEqual() of the same values should be true")
+	}
+	if valid.ValueEqual(zero) || null.ValueEqual(valid) {
+		t.Error("ValueEqual() of different values should be false")
+	}
+}
+
+func TestTimeIsNullIsZeroTime(t *testing.T) {
+	tests := []struct {
+		in       Time
+		null     bool
+		zeroTime bool
+	}{
+		{TimeFrom(timeValue1), false, false},
+		{TimeFrom(time.Time{}), false, true},
+		{NewTime(timeValue1, false), true, false},
+		{NewTime(time.Time{}, false), true, false},
+	}
+	for _, test := range tests {
+		if got := test.in.IsNull(); got != test.null {
+			t.Errorf("IsNull() of %#v = %t, want %t", test.in, got, test.null)
+		}
+		if got := test.in.IsZeroTime(); got != test.zeroTime {
+			t.Errorf("IsZeroTime() of %#v = %t, want %t", test.in, got, test.zeroTime)
+		}
+		if test.in.IsZero() != test.null {
+			t.Errorf("IsZero() of %#v should match IsNull()", test.in)
+		}
+	}
+}
+
 func assertTime(t *testing.T, ti Time, from string) {
 	if ti.Time != timeValue1 {
 		t.Errorf("bad %v time: %v ≠ %v\n", from, ti.Time, timeValue1)
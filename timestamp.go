@@ -5,18 +5,67 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// TimestampTextAsRFC3339 makes Timestamp.MarshalText encode RFC 3339 strings such as 2012-12-21T21:21:21Z
+// instead of Unix epoch seconds, for URL paths and query strings meant to be read by people.
+// UnmarshalText accepts both forms regardless. JSON encoding is not affected.
+//
+// Deprecated: set Config.TimestampTextAsRFC3339 with SetConfig, which is safe to call while values are decoded.
+// This variable is ignored once SetConfig has been called.
+var TimestampTextAsRFC3339 = false
+
 // Timestamp is a nullable time.Time. It supports SQL and JSON serialization.
 // It will marshal to null if null.
 type Timestamp struct {
 	sql.NullTime
 }
 
+// Scan implements the sql.Scanner interface.
+// The scanned time is stored as is, so it keeps whatever location the driver returned.
+// Like UnmarshalJSON, it also accepts int64 and float64 input as seconds since the Unix epoch,
+// for columns that store the epoch instead of a time.
+// String and []byte input such as "2012-12-21 21:21:21.123456+00:00" is parsed as well, keeping fractional seconds,
+// for drivers that return times as text. Times without an offset are taken to be UTC.
+// Note that MarshalJSON only encodes whole seconds.
+func (t *Timestamp) Scan(value interface{}) error {
+	return reportError(t.scan(value))
+}
+
+func (t *Timestamp) scan(value interface{}) error {
+	var err error
+	switch v := scanSource(value).(type) {
+	case int64:
+		t.Time, t.Valid = time.Unix(v, 0), true
+	case float64:
+		t.Time, err = parseEpoch(strconv.FormatFloat(v, 'f', -1, 64))
+		t.Valid = true
+	case string:
+		t.Time, err = parseTimeWith(timestampScanLayouts, v)
+		t.Valid = true
+	case []byte:
+		t.Time, err = parseTimeWith(timestampScanLayouts, string(v))
+		t.Valid = true
+	default:
+		err = t.NullTime.Scan(v)
+	}
+	if err != nil {
+		t.Valid = false
+		return newScanError("Timestamp", value, err)
+	}
+	return nil
+}
+
 // Value implements the driver Valuer interface.
+// The time is passed to the driver with its location unchanged.
+// Whether the location survives a round trip depends on the driver and column type:
+// many drivers store times as UTC.
 func (t Timestamp) Value() (driver.Value, error) {
 	if !t.Valid {
 		return nil, nil
@@ -24,27 +73,59 @@ func (t Timestamp) Value() (driver.Value, error) {
 	return t.Time, nil
 }
 
+// ValueUTC is like Value, but converts the time to UTC first.
+// Use it to store a normalized time regardless of the driver's behavior.
+func (t Timestamp) ValueUTC() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.UTC(), nil
+}
+
 // NewTimestamp creates a new Timestamp.
-func NewTimestamp(t time.Time, valid bool) Timestamp {
-	return Timestamp{
-		NullTime: sql.NullTime{
-			Time:  t,
-			Valid: valid,
-		},
+// Options may change the time or make it null, see TimeOption.
+func NewTimestamp(t time.Time, valid bool, opts ...TimeOption) Timestamp {
+	nt := sql.NullTime{
+		Time:  t,
+		Valid: valid,
 	}
+	applyTimeOptions(&nt, opts)
+	return Timestamp{NullTime: nt}
 }
 
-// TimestampFrom creates a new Timestamp that will always be valid.
-func TimestampFrom(t time.Time) Timestamp {
-	return NewTimestamp(t, true)
+// TimestampFrom creates a new Timestamp that will always be valid, unless an option makes it null.
+// The time is kept as is, but MarshalJSON and MarshalText only encode whole seconds;
+// use HasSubSecond to detect values that would lose precision, or WithPrecision(time.Second) to truncate them.
+func TimestampFrom(t time.Time, opts ...TimeOption) Timestamp {
+	return NewTimestamp(t, true, opts...)
 }
 
 // TimestampFromPtr creates a new Timestamp that will be null if t is nil.
-func TimestampFromPtr(t *time.Time) Timestamp {
+func TimestampFromPtr(t *time.Time, opts ...TimeOption) Timestamp {
 	if t == nil {
 		return NewTimestamp(time.Time{}, false)
 	}
-	return NewTimestamp(*t, true)
+	return NewTimestamp(*t, true, opts...)
+}
+
+// TimestampFromResult creates a new Timestamp from the results of a function returning (time.Time, error).
+// It returns a null Timestamp and err if err is not nil, otherwise a valid Timestamp.
+func TimestampFromResult(v time.Time, err error) (Timestamp, error) {
+	if err != nil {
+		return NewTimestamp(time.Time{}, false), err
+	}
+	return NewTimestamp(v, true), nil
+}
+
+// TimestampNow creates a new Timestamp holding the current time, that will always be valid unless an option makes it null.
+func TimestampNow(opts ...TimeOption) Timestamp {
+	return TimestampFrom(Now(), opts...)
+}
+
+// TimestampFromZero creates a new Timestamp that will be null if t is the zero time.
+// It is the opposite of ValueOrZero.
+func TimestampFromZero(t time.Time) Timestamp {
+	return NewTimestamp(t, !t.IsZero())
 }
 
 // ValueOrZero returns the inner value if valid, otherwise zero.
@@ -55,6 +136,14 @@ func (t Timestamp) ValueOrZero() time.Time {
 	return t.Time
 }
 
+// ValueOr returns the inner value if valid, otherwise def.
+func (t Timestamp) ValueOr(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+	return t.Time
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this timestamp is null.
 func (t Timestamp) MarshalJSON() ([]byte, error) {
@@ -66,32 +155,102 @@ func (t Timestamp) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports int64 and null input.
+// Non-integer numbers such as 1.356124881e9 are supported as well, keeping fractions of a second,
+// and so are RFC 3339 strings such as "2012-12-21T21:21:21Z".
 func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	return reportError(t.unmarshalJSON(data))
+}
+
+func (t *Timestamp) unmarshalJSON(data []byte) error {
+	data = trimJSON(data)
 	if bytes.Equal(data, nullBytes) {
 		t.Valid = false
 		return nil
 	}
+	if len(data) > 0 && data[0] == '"' {
+		return t.unmarshalJSONString(data)
+	}
 	var v int64
 	if err := json.Unmarshal(data, &v); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		var typeError *json.UnmarshalTypeError
+		if !errors.As(err, &typeError) || !strings.HasPrefix(typeError.Value, "number") {
+			*t = Timestamp{}
+			return newUnmarshalError("Timestamp", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+		}
+		ti, err := parseEpoch(string(data))
+		if err != nil {
+			*t = Timestamp{}
+			return newUnmarshalError("Timestamp", err)
+		}
+		t.Time = ti
+		t.Valid = true
+		return nil
 	}
 	t.Time = time.Unix(v, 0)
 	t.Valid = true
 	return nil
 }
 
+// unmarshalJSONString parses a JSON string holding an RFC 3339 time.
+func (t *Timestamp) unmarshalJSONString(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		*t = Timestamp{}
+		return newUnmarshalError("Timestamp", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	parsed, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		*t = Timestamp{}
+		return newUnmarshalError("Timestamp", fmt.Errorf("couldn't unmarshal JSON: %w", err))
+	}
+	t.Time = parsed
+	t.Valid = true
+	return nil
+}
+
+// parseEpoch parses a number of seconds since the Unix epoch, which may have a fraction or an exponent.
+// Fractions are truncated to whole nanoseconds.
+func parseEpoch(str string) (time.Time, error) {
+	r, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid number %q", str)
+	}
+	sec, rem := new(big.Int).DivMod(r.Num(), r.Denom(), new(big.Int))
+	if !sec.IsInt64() {
+		return time.Time{}, fmt.Errorf("number %q overflows int64", str)
+	}
+	nsec := rem.Mul(rem, big.NewInt(int64(time.Second)))
+	nsec.Quo(nsec, r.Denom())
+	return time.Unix(sec.Int64(), nsec.Int64()), nil
+}
+
 // MarshalText implements encoding.TextMarshaler.
 // It returns an empty string if invalid, otherwise int64.
+// If TimestampTextAsRFC3339 is set, it returns an RFC 3339 string with whole seconds instead.
 func (t Timestamp) MarshalText() ([]byte, error) {
+	return t.AppendText(make([]byte, 0, len(time.RFC3339)))
+}
+
+// AppendText implements encoding.TextAppender, appending the encoding of MarshalText to b.
+// Reusing b avoids the allocation MarshalText makes for every call.
+func (t Timestamp) AppendText(b []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte{}, nil
+		return b, nil
 	}
-	return []byte(strconv.FormatInt(t.Time.Unix(), 10)), nil
+	if timestampTextAsRFC3339() {
+		return t.Time.AppendFormat(b, time.RFC3339), nil
+	}
+	return strconv.AppendInt(b, t.Time.Unix(), 10), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null int64 Unix timestamp to time.Time if the input is a blank or not an time.Time.
+// RFC 3339 strings, as encoded when TimestampTextAsRFC3339 is set, are accepted too.
 func (t *Timestamp) UnmarshalText(text []byte) error {
+	return reportError(t.unmarshalText(text))
+}
+
+func (t *Timestamp) unmarshalText(text []byte) error {
 	str := string(text)
 	// allowing "null" is for backwards compatibility with v3
 	if str == "" || str == "null" {
@@ -100,13 +259,85 @@ func (t *Timestamp) UnmarshalText(text []byte) error {
 	}
 	v, err := strconv.ParseInt(str, 0, 64)
 	if err != nil {
-		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+		parsed, timeErr := time.Parse(time.RFC3339, str)
+		if timeErr != nil {
+			return newUnmarshalError("Timestamp", fmt.Errorf("couldn't unmarshal text: %w", err))
+		}
+		t.Time = parsed
+		t.Valid = true
+		return nil
 	}
 	t.Time = time.Unix(v, 0)
 	t.Valid = true
 	return nil
 }
 
+// Format returns the time formatted according to layout, or a blank string if this Timestamp is null.
+// It's meant for display only and doesn't affect marshaling.
+func (t Timestamp) Format(layout string) string {
+	return t.FormatOr(layout, "")
+}
+
+// FormatOr returns the time formatted according to layout, or fallback if this Timestamp is null.
+func (t Timestamp) FormatOr(layout, fallback string) string {
+	if !t.Valid {
+		return fallback
+	}
+	return t.Time.Format(layout)
+}
+
+// AtLocation returns a Timestamp with the same wall clock time, but in loc.
+// Unlike time.Time's In, it changes the instant: 15:00 UTC becomes 15:00 in loc.
+// A null Timestamp is returned unchanged.
+func (t Timestamp) AtLocation(loc *time.Location) Timestamp {
+	if !t.Valid {
+		return t
+	}
+	y, mon, d := t.Time.Date()
+	h, min, s := t.Time.Clock()
+	return TimestampFrom(time.Date(y, mon, d, h, min, s, t.Time.Nanosecond(), loc))
+}
+
+// Since returns the time elapsed between this Timestamp and Now.
+// It returns false if this Timestamp is null.
+func (t Timestamp) Since() (time.Duration, bool) {
+	return t.SinceWithClock(Now())
+}
+
+// SinceWithClock is like Since, but measures the time elapsed until now instead of the current time.
+func (t Timestamp) SinceWithClock(now time.Time) (time.Duration, bool) {
+	if !t.Valid {
+		return 0, false
+	}
+	return now.Sub(t.Time), true
+}
+
+// Until returns the duration from Now until this Timestamp.
+// It returns false if this Timestamp is null.
+func (t Timestamp) Until() (time.Duration, bool) {
+	return t.UntilWithClock(Now())
+}
+
+// UntilWithClock is like Until, but measures the duration from now instead of the current time.
+func (t Timestamp) UntilWithClock(now time.Time) (time.Duration, bool) {
+	if !t.Valid {
+		return 0, false
+	}
+	return t.Time.Sub(now), true
+}
+
+// IsPast returns true if this Timestamp is valid and before Now.
+// A Timestamp equal to Now is neither past nor future.
+func (t Timestamp) IsPast() bool {
+	return t.Valid && t.Time.Before(Now())
+}
+
+// IsFuture returns true if this Timestamp is valid and after Now.
+// A Timestamp equal to Now is neither past nor future.
+func (t Timestamp) IsFuture() bool {
+	return t.Valid && t.Time.After(Now())
+}
+
 // SetValid changes this Timestamp's value and sets it to be non-null.
 func (t *Timestamp) SetValid(v time.Time) {
 	t.Time = v
@@ -121,12 +352,49 @@ func (t Timestamp) Ptr() *time.Time {
 	return &t.Time
 }
 
+// ToTime converts this Timestamp to a Time with the same time.Time and validity, keeping full precision.
+// It can't be named Time, as that would hide the Time field.
+func (t Timestamp) ToTime() Time {
+	return Time{NullTime: t.NullTime}
+}
+
+// HasSubSecond returns true if this Timestamp is valid and has a fraction of a second,
+// which MarshalJSON and MarshalText would drop.
+func (t Timestamp) HasSubSecond() bool {
+	return t.Valid && t.Time.Nanosecond() != 0
+}
+
+// TruncatedToSecond returns true if this Timestamp marshals without losing precision:
+// it is null or falls on a whole second. It is the opposite of HasSubSecond.
+func (t Timestamp) TruncatedToSecond() bool {
+	return !t.HasSubSecond()
+}
+
+// Unwrap returns this Timestamp as a sql.NullTime, for code that needs the standard library type.
+func (t Timestamp) Unwrap() sql.NullTime {
+	return t.NullTime
+}
+
+// Reset makes this Timestamp null and clears its value, so a reused Timestamp doesn't keep previous data.
+func (t *Timestamp) Reset() {
+	*t = Timestamp{}
+}
+
 // IsZero returns true for invalid Times, hopefully for future omitempty support.
 // A non-null Time with a zero value will not be considered zero.
 func (t Timestamp) IsZero() bool {
 	return !t.Valid
 }
 
+// AsAny returns nil if this Timestamp is null, otherwise its value as time.Time.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (t Timestamp) AsAny() interface{} {
+	if !t.Valid {
+		return nil
+	}
+	return t.Time
+}
+
 // Equal returns true if both Timestamp objects encode the same time or are both null.
 // Two times can be equal even if they are in different locations.
 // For example, 6:00 +0200 CEST and 4:00 UTC are Equal.
@@ -134,9 +402,59 @@ func (t Timestamp) Equal(other Timestamp) bool {
 	return t.Valid == other.Valid && (!t.Valid || t.Time.Equal(other.Time))
 }
 
+// Compare returns -1, 0 or +1 depending on whether t is less than, equal to or greater than other.
+// A null Timestamp sorts before all valid ones.
+func (t Timestamp) Compare(other Timestamp) int {
+	if c, ok := compareNull(t.Valid, other.Valid); ok {
+		return c
+	}
+	return compareTime(t.Time, other.Time)
+}
+
+// Less returns true if t sorts before other. A null Timestamp sorts before all valid ones.
+func (t Timestamp) Less(other Timestamp) bool {
+	return t.Compare(other) < 0
+}
+
+// ValueEqual returns true if both Timestamp objects encode the same time, treating null as the zero time.
+// Unlike Equal, a null Timestamp is ValueEqual to a valid zero time.
+func (t Timestamp) ValueEqual(other Timestamp) bool {
+	return t.ValueOrZero().Equal(other.ValueOrZero())
+}
+
 // ExactEqual returns true if both Timestamp objects are equal or both null.
 // ExactEqual returns false for times that are in different locations or
 // have a different monotonic clock reading.
 func (t Timestamp) ExactEqual(other Timestamp) bool {
 	return t.Valid == other.Valid && (!t.Valid || t.Time == other.Time)
 }
+
+// EqualWithin returns true if both Timestamp objects are null or
+// their times are at most d apart, in either direction.
+func (t Timestamp) EqualWithin(other Timestamp, d time.Duration) bool {
+	if t.Valid != other.Valid {
+		return false
+	}
+	if !t.Valid {
+		return true
+	}
+	diff := t.Time.Sub(other.Time)
+	return diff <= d && diff >= -d
+}
+
+// JSONSchema returns the JSON Schema of the JSON this Timestamp accepts: a number of seconds since the Unix epoch,
+// which may have a fraction or an exponent, an RFC 3339 string, or null.
+func (t Timestamp) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("number", "string")
+	schema["format"] = "date-time"
+	schema["description"] = "Unix time in seconds, or an RFC 3339 time"
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Timestamp as the Go code that creates it.
+func (t Timestamp) GoString() string {
+	if !t.Valid {
+		return `null.NewTimestamp(time.Time{}, false)`
+	}
+	return "null.TimestampFrom(" + goTime(t.Time) + ")"
+}
@@ -54,13 +54,67 @@ func TestUnmarshalTimestampJSON(t *testing.T) {
 	assertNullTimestamp(t, bad, "bad from object json")
 
 	var wrongType Timestamp
-	err = json.Unmarshal(timeJSON, &wrongType)
+	err = json.Unmarshal(boolJSON, &wrongType)
 	if err == nil {
 		t.Errorf("expected error: wrong type JSON")
 	}
 	assertNullTimestamp(t, wrongType, "wrong type object json")
 }
 
+func TestUnmarshalTimestampRFC3339JSON(t *testing.T) {
+	var ti Timestamp
+	err := json.Unmarshal([]byte(`"2012-12-21T21:21:21Z"`), &ti)
+	maybePanic(err)
+	if !ti.Valid || !ti.Time.Equal(timestampValue) {
+		t.Errorf("bad RFC 3339 JSON: %v ≠ %v", ti.Time, timestampValue)
+	}
+
+	var fromInt Timestamp
+	err = json.Unmarshal(timestampJSON, &fromInt)
+	maybePanic(err)
+	assertTimestamp(t, fromInt, "int json after RFC 3339 support")
+
+	notTime := TimestampFrom(timestampValue)
+	err = json.Unmarshal(stringJSON, &notTime)
+	var unmarshalErr *UnmarshalError
+	if !errors.As(err, &unmarshalErr) {
+		t.Errorf("expected *UnmarshalError for a non-time string, not %T", err)
+	}
+	assertNullTimestamp(t, notTime, "non-time string json")
+}
+
+func TestUnmarshalTimestampExponent(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{`1.356124881e9`, timestampValue},
+		{`1.3561248815e9`, time.Unix(1356124881, 500000000)},
+		{`1356124881.25`, time.Unix(1356124881, 250000000)},
+		{`1.356124881E+9`, timestampValue},
+		{`-1.5e0`, time.Unix(-2, 500000000)},
+	}
+	for _, test := range tests {
+		var ti Timestamp
+		err := json.Unmarshal([]byte(test.in), &ti)
+		maybePanic(err)
+		if !ti.Valid || !ti.Time.Equal(test.want) {
+			t.Errorf("bad %s: %v ≠ %v", test.in, ti.Time, test.want)
+		}
+	}
+
+	overflow := TimestampFrom(timestampValue)
+	err := json.Unmarshal([]byte(`1e100`), &overflow)
+	var unmarshalErr *UnmarshalError
+	if !errors.As(err, &unmarshalErr) {
+		t.Errorf("expected *UnmarshalError for an overflowing number, not %T", err)
+	}
+	assertNullTimestamp(t, overflow, "overflowing number json")
+	if !overflow.Time.IsZero() {
+		t.Errorf("overflowing number json kept %v", overflow.Time)
+	}
+}
+
 func TestUnmarshalTimestampText(t *testing.T) {
 	ti := TimestampFrom(timestampValue)
 	txt, err := ti.MarshalText()
@@ -88,6 +142,53 @@ func TestUnmarshalTimestampText(t *testing.T) {
 	assertNullTimestamp(t, invalid, "bad string")
 }
 
+func TestTimestampTextAsRFC3339(t *testing.T) {
+	defer func(prev bool) { TimestampTextAsRFC3339 = prev }(TimestampTextAsRFC3339)
+	ti := TimestampFrom(timestampValue.UTC())
+
+	for _, test := range []struct {
+		rfc3339 bool
+		want    string
+	}{
+		{false, timestampString},
+		{true, "2012-12-21T21:21:21Z"},
+	} {
+		TimestampTextAsRFC3339 = test.rfc3339
+		txt, err := ti.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, txt, test.want, "marshal text")
+		appended, err := ti.AppendText([]byte("at "))
+		maybePanic(err)
+		assertJSONEquals(t, appended, "at "+test.want, "append text")
+
+		// both forms are accepted regardless of the setting
+		for _, in := range []string{timestampString, "2012-12-21T21:21:21Z", "2012-12-22T06:21:21+09:00"} {
+			var back Timestamp
+			err = back.UnmarshalText([]byte(in))
+			maybePanic(err)
+			if !back.Valid || !back.Time.Equal(timestampValue) {
+				t.Errorf("bad UnmarshalText(%s): %v", in, back.Time)
+			}
+		}
+
+		null := NewTimestamp(timestampValue, false)
+		txt, err = null.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, txt, "", "marshal null text")
+		appended, err = null.AppendText([]byte("at "))
+		maybePanic(err)
+		assertJSONEquals(t, appended, "at ", "append null text")
+		back := ti
+		err = back.UnmarshalText([]byte(""))
+		maybePanic(err)
+		assertNullTimestamp(t, back, "unmarshal blank text")
+
+		data, err := json.Marshal(ti)
+		maybePanic(err)
+		assertJSONEquals(t, data, timestampString, "json is unaffected")
+	}
+}
+
 func TestMarshalTimestamp(t *testing.T) {
 	ti := TimestampFrom(timestampValue)
 	data, err := json.Marshal(ti)
@@ -154,12 +255,220 @@ func TestTimestampScanValue(t *testing.T) {
 	}
 
 	var wrong Timestamp
-	err = wrong.Scan(int64(42))
+	err = wrong.Scan(true)
 	if err == nil {
 		t.Error("expected error")
 	}
 }
 
+func TestTimestampScanMatchesJSON(t *testing.T) {
+	tests := []struct {
+		json string
+		sql  interface{}
+	}{
+		{"1356124881", int64(1356124881)},
+		{"-1", int64(-1)},
+		{"1356124881.5", float64(1356124881.5)},
+	}
+	for _, test := range tests {
+		var fromJSON, fromSQL Timestamp
+		err := json.Unmarshal([]byte(test.json), &fromJSON)
+		maybePanic(err)
+		err = fromSQL.Scan(test.sql)
+		maybePanic(err)
+		if !fromSQL.ExactEqual(fromJSON) {
+			t.Errorf("Scan(%v) = %v, but UnmarshalJSON(%s) = %v", test.sql, fromSQL.Time, test.json, fromJSON.Time)
+		}
+	}
+
+	var ts Timestamp
+	err := ts.Scan(int64(1356124881))
+	maybePanic(err)
+	assertTimestamp(t, ts, "scanned epoch")
+}
+
+func TestTimestampScanString(t *testing.T) {
+	want := time.Date(2012, 12, 21, 21, 21, 21, 123456000, time.UTC)
+	tests := []interface{}{
+		"2012-12-21 21:21:21.123456",
+		[]byte("2012-12-21 21:21:21.123456"),
+		"2012-12-21 21:21:21.123456+00:00",
+		"2012-12-21 23:21:21.123456+02:00",
+		"2012-12-21 21:21:21.123456Z",
+		"2012-12-21T21:21:21.123456Z",
+	}
+	for _, test := range tests {
+		var ts Timestamp
+		err := ts.Scan(test)
+		maybePanic(err)
+		if !ts.Valid || !ts.Time.Equal(want) {
+			t.Errorf("Scan(%q) = %v, want %v", test, ts.Time, want)
+		}
+	}
+
+	var whole Timestamp
+	err := whole.Scan("2012-12-21 21:21:21.000000")
+	maybePanic(err)
+	if !whole.Time.Equal(timestampValue) {
+		t.Errorf("bad scanned MySQL datetime: %v ≠ %v", whole.Time, timestampValue)
+	}
+
+	var bad Timestamp
+	if err := bad.Scan("21/12/2012"); err == nil {
+		t.Error("expected error")
+	}
+	assertNullTimestamp(t, bad, "scanned bad string")
+}
+
+func TestTimestampScanValueLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+9", 9*60*60)
+	ti := TimestampFrom(timestampValue.In(loc))
+
+	db := openFakeDB(t.Name())
+	defer db.Close()
+	_, err := db.Exec("INSERT", ti)
+	maybePanic(err)
+
+	var scanned Timestamp
+	err = db.QueryRow("SELECT").Scan(&scanned)
+	maybePanic(err)
+	if !scanned.ExactEqual(ti) {
+		t.Errorf("round trip changed the time: %v ≠ %v", scanned.Time, ti.Time)
+	}
+	if scanned.Time.Location() != loc {
+		t.Errorf("round trip lost the location: %v ≠ %v", scanned.Time.Location(), loc)
+	}
+
+	v, err := ti.ValueUTC()
+	maybePanic(err)
+	if utc, ok := v.(time.Time); !ok || utc.Location() != time.UTC || !utc.Equal(timestampValue) {
+		t.Errorf("bad ValueUTC(): %v", v)
+	}
+	if v, err := NewTimestamp(timestampValue, false).ValueUTC(); v != nil || err != nil {
+		t.Error("bad value or err:", v, err)
+	}
+}
+
+func TestTimestampAtLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	naive := TimestampFrom(time.Date(2012, 12, 21, 15, 0, 0, 500, time.UTC))
+
+	at := naive.AtLocation(loc)
+	if want := time.Date(2012, 12, 21, 15, 0, 0, 500, loc); !at.Valid || at.Time != want {
+		t.Errorf("bad AtLocation(): %v ≠ %v", at.Time, want)
+	}
+
+	in := naive.Time.In(loc)
+	if h := in.Hour(); h != 17 {
+		t.Errorf("In() should convert the instant, got hour %d", h)
+	}
+	if !in.Equal(naive.Time) {
+		t.Error("In() should keep the instant")
+	}
+	if at.Time.Equal(naive.Time) {
+		t.Error("AtLocation() should change the instant")
+	}
+
+	null := NewTimestamp(naive.Time, false)
+	if got := null.AtLocation(loc); got.Valid || got.Time != naive.Time {
+		t.Error("AtLocation() should return a null Timestamp unchanged")
+	}
+}
+
+func TestTimestampSinceUntil(t *testing.T) {
+	ti := TimestampFrom(timestampValue)
+	now := timestampValue.Add(90 * time.Second)
+
+	if d, ok := ti.SinceWithClock(now); !ok || d != 90*time.Second {
+		t.Errorf("SinceWithClock() = %v, %t, want 1m30s, true", d, ok)
+	}
+	if d, ok := ti.UntilWithClock(now); !ok || d != -90*time.Second {
+		t.Errorf("UntilWithClock() = %v, %t, want -1m30s, true", d, ok)
+	}
+	if d, ok := ti.Since(); !ok || d <= 0 {
+		t.Errorf("Since() = %v, %t, want a positive duration", d, ok)
+	}
+	if d, ok := ti.Until(); !ok || d >= 0 {
+		t.Errorf("Until() = %v, %t, want a negative duration", d, ok)
+	}
+
+	null := NewTimestamp(timestampValue, false)
+	if d, ok := null.SinceWithClock(now); ok || d != 0 {
+		t.Errorf("SinceWithClock() of null = %v, %t, want 0, false", d, ok)
+	}
+	if d, ok := null.UntilWithClock(now); ok || d != 0 {
+		t.Errorf("UntilWithClock() of null = %v, %t, want 0, false", d, ok)
+	}
+	if _, ok := null.Since(); ok {
+		t.Error("Since() of null should not be ok")
+	}
+	if _, ok := null.Until(); ok {
+		t.Error("Until() of null should not be ok")
+	}
+}
+
+func TestTimestampSinceUntilUseNow(t *testing.T) {
+	defer func(prev func() time.Time) { Now = prev }(Now)
+	Now = func() time.Time { return timestampValue.Add(time.Minute) }
+
+	ti := TimestampFrom(timestampValue)
+	if d, ok := ti.Since(); !ok || d != time.Minute {
+		t.Errorf("Since() = %v, %t, want 1m0s, true", d, ok)
+	}
+	if d, ok := ti.Until(); !ok || d != -time.Minute {
+		t.Errorf("Until() = %v, %t, want -1m0s, true", d, ok)
+	}
+	if now := TimestampNow(); !now.Time.Equal(Now()) {
+		t.Errorf("TimestampNow() = %v, want %v", now.Time, Now())
+	}
+}
+
+func TestTimestampIsPastFuture(t *testing.T) {
+	defer func(prev func() time.Time) { Now = prev }(Now)
+	Now = func() time.Time { return timestampValue }
+
+	tests := []struct {
+		name         string
+		ts           Timestamp
+		past, future bool
+	}{
+		{"past", TimestampFrom(timestampValue.Add(-time.Second)), true, false},
+		{"future", TimestampFrom(timestampValue.Add(time.Second)), false, true},
+		{"now", TimestampFrom(timestampValue), false, false},
+		{"null", NewTimestamp(timestampValue.Add(-time.Second), false), false, false},
+	}
+	for _, test := range tests {
+		if got := test.ts.IsPast(); got != test.past {
+			t.Errorf("%s: IsPast() = %t, want %t", test.name, got, test.past)
+		}
+		if got := test.ts.IsFuture(); got != test.future {
+			t.Errorf("%s: IsFuture() = %t, want %t", test.name, got, test.future)
+		}
+	}
+}
+
+func TestTimestampToTime(t *testing.T) {
+	precise := timestampValue.Add(123456789)
+	ts := TimestampFrom(precise)
+	ti := ts.ToTime()
+	if !ti.Valid || !ti.Time.Equal(precise) {
+		t.Errorf("ToTime() lost precision: %v", ti.Time)
+	}
+	if back := ti.Timestamp(); !back.ExactEqual(ts) {
+		t.Errorf("round trip changed %v to %v", ts.Time, back.Time)
+	}
+
+	null := NewTimestamp(timestampValue, false)
+	if null.ToTime().Valid || null.ToTime().Timestamp().Valid {
+		t.Error("conversion of a null Timestamp should be null")
+	}
+
+	// converting is lossless, marshaling the Timestamp is not
+	data, err := json.Marshal(TimeFrom(precise).Timestamp())
+	maybePanic(err)
+	assertJSONEquals(t, data, timestampString, "converted Time json marshal")
+}
+
 func TestTimestampValueOrZero(t *testing.T) {
 	valid := TimestampFrom(timestampValue)
 	if valid.ValueOrZero() != valid.Time || valid.ValueOrZero().IsZero() {
@@ -250,6 +559,77 @@ func TestTimestampExactEqual(t *testing.T) {
 	assertTimestampExactEqualIsFalse(t, t1, t2)
 }
 
+func TestTimestampEqualWithin(t *testing.T) {
+	t1 := NewTimestamp(timeValue1, true)
+	t2 := NewTimestamp(timeValue1.Add(5*time.Second), true)
+	if !t1.EqualWithin(t2, 5*time.Second) || !t2.EqualWithin(t1, 5*time.Second) {
+		t.Error("EqualWithin() should be true for times exactly d apart")
+	}
+	if t1.EqualWithin(t2, 5*time.Second-time.Nanosecond) {
+		t.Error("EqualWithin() should be false for times more than d apart")
+	}
+	if !t1.EqualWithin(NewTimestamp(timeValue2, true), 0) {
+		t.Error("EqualWithin() should be true for the same time in different locations")
+	}
+
+	null1 := NewTimestamp(timeValue1, false)
+	null2 := NewTimestamp(timeValue3, false)
+	if !null1.EqualWithin(null2, 0) {
+		t.Error("EqualWithin() should be true for two null Timestamps")
+	}
+	if t1.EqualWithin(null1, time.Hour) || null1.EqualWithin(t1, time.Hour) {
+		t.Error("EqualWithin() should be false for a null and a valid Timestamp")
+	}
+}
+
+func TestTimestampFormat(t *testing.T) {
+	ti := TimestampFrom(timestampValue.UTC())
+	if got := ti.Format("2006-01-02 15:04"); got != "2012-12-21 21:21" {
+		t.Errorf("Format() = %q, want %q", got, "2012-12-21 21:21")
+	}
+	if got := ti.FormatOr("2006-01-02", "n/a"); got != "2012-12-21" {
+		t.Errorf("FormatOr() = %q, want %q", got, "2012-12-21")
+	}
+
+	null := NewTimestamp(timestampValue, false)
+	if got := null.Format("2006-01-02"); got != "" {
+		t.Errorf("Format() of null = %q, want blank", got)
+	}
+	if got := null.FormatOr("2006-01-02", "n/a"); got != "n/a" {
+		t.Errorf("FormatOr() of null = %q, want %q", got, "n/a")
+	}
+}
+
+func TestTimestampValueOr(t *testing.T) {
+	valid := NewTimestamp(timestampValue, true)
+	if !valid.ValueOr(timeValue3).Equal(timestampValue) {
+		t.Error("unexpected ValueOr", valid.ValueOr(timeValue3))
+	}
+
+	invalid := NewTimestamp(timestampValue, false)
+	if !invalid.ValueOr(timeValue3).Equal(timeValue3) {
+		t.Error("unexpected ValueOr", invalid.ValueOr(timeValue3))
+	}
+}
+
+func TestTimestampValueEqual(t *testing.T) {
+	null := NewTimestamp(timestampValue, false)
+	zero := NewTimestamp(time.Time{}, true)
+	valid := NewTimestamp(timestampValue, true)
+	if !null.ValueEqual(zero) || !zero.ValueEqual(null) {
+		t.Error("ValueEqual() of null and valid zero should be true")
+	}
+	if null.Equal(zero) {
+		t.Error("Equal() of null and valid zero should be false")
+	}
+	if !null.ValueEqual(null) || !valid.ValueEqual(valid) {
+		t.Error("ValueEqual() of the same values should be true")
+	}
+	if valid.ValueEqual(zero) || null.ValueEqual(valid) {
+		t.Error("ValueEqual() of different values should be false")
+	}
+}
+
 func assertTimestamp(t *testing.T, ti Timestamp, from string) {
 	if ti.Time != timestampValue {
 		t.Errorf("bad %v time: %v ≠ %v\n", from, ti.Time, timestampValue)
@@ -292,3 +672,64 @@ func assertTimestampExactEqualIsFalse(t *testing.T, a, b Timestamp) {
 		t.Errorf("ExactEqual() of Timestamp{%v, Valid:%t} and Timestamp{%v, Valid:%t} should return false", a.Time, a.Valid, b.Time, b.Valid)
 	}
 }
+
+func TestTimestampNegativeEpoch(t *testing.T) {
+	// 1900-01-01T00:00:00Z
+	const epoch = "-2208988800"
+	want := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var fromJSON Timestamp
+	err := json.Unmarshal([]byte(epoch), &fromJSON)
+	maybePanic(err)
+	if !fromJSON.Valid || !fromJSON.Time.Equal(want) {
+		t.Errorf("bad UnmarshalJSON(%s): %v", epoch, fromJSON.Time)
+	}
+	data, err := json.Marshal(fromJSON)
+	maybePanic(err)
+	assertJSONEquals(t, data, epoch, "negative epoch json marshal")
+
+	var fromText Timestamp
+	err = fromText.UnmarshalText([]byte(epoch))
+	maybePanic(err)
+	if !fromText.ExactEqual(fromJSON) {
+		t.Errorf("bad UnmarshalText(%s): %v", epoch, fromText.Time)
+	}
+	txt, err := fromText.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, epoch, "negative epoch text marshal")
+
+	var fromSQL Timestamp
+	err = fromSQL.Scan(int64(-2208988800))
+	maybePanic(err)
+	if !fromSQL.ExactEqual(fromJSON) {
+		t.Errorf("bad Scan(%s): %v", epoch, fromSQL.Time)
+	}
+	v, err := fromSQL.Value()
+	maybePanic(err)
+	var back Timestamp
+	err = back.Scan(v)
+	maybePanic(err)
+	if !back.Time.Equal(want) {
+		t.Errorf("bad SQL round trip: %v", back.Time)
+	}
+}
+
+func TestTimestampHasSubSecond(t *testing.T) {
+	tests := []struct {
+		ts        Timestamp
+		subSecond bool
+	}{
+		{TimestampFrom(timestampValue.Add(123456789)), true},
+		{TimestampFrom(timestampValue.Add(time.Millisecond)), true},
+		{TimestampFrom(timestampValue), false},
+		{NewTimestamp(timestampValue.Add(1), false), false},
+	}
+	for _, test := range tests {
+		if got := test.ts.HasSubSecond(); got != test.subSecond {
+			t.Errorf("%#v.HasSubSecond() = %v, want %v", test.ts, got, test.subSecond)
+		}
+		if got := test.ts.TruncatedToSecond(); got == test.subSecond {
+			t.Errorf("%#v.TruncatedToSecond() = %v, want %v", test.ts, got, !test.subSecond)
+		}
+	}
+}
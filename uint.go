@@ -0,0 +1,188 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Uint is a nullable uint64.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// Negative input is rejected with an error wrapping ErrOverflow, and so is input above math.MaxInt64,
+// the largest integer database/sql can pass to a driver.
+type Uint struct {
+	Uint64 uint64
+	Valid  bool
+}
+
+// NewUint creates a new Uint
+func NewUint(i uint64, valid bool) Uint {
+	return Uint{Uint64: i, Valid: valid}
+}
+
+// UintFrom creates a new Uint that will always be valid.
+func UintFrom(i uint64) Uint {
+	return NewUint(i, true)
+}
+
+// UintFromPtr creates a new Uint that be null if i is nil.
+func UintFromPtr(i *uint64) Uint {
+	if i == nil {
+		return NewUint(0, false)
+	}
+	return NewUint(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint) ValueOrZero() uint64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint64
+}
+
+// ValueOr returns the inner value if valid, otherwise def.
+func (i Uint) ValueOr(def uint64) uint64 {
+	if !i.Valid {
+		return def
+	}
+	return i.Uint64
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if the value is negative.
+func (i *Uint) Scan(value interface{}) error {
+	return reportError(i.scan(value))
+}
+
+func (i *Uint) scan(value interface{}) error {
+	n, err := scanInt(value)
+	if err != nil {
+		i.Valid = false
+		return newScanError("Uint", value, err)
+	}
+	return i.set(n, nil)
+}
+
+// Value implements the driver Valuer interface.
+// It returns an error if the value is above math.MaxInt64.
+func (i Uint) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	if i.Uint64 > math.MaxInt64 {
+		return nil, fmt.Errorf("null: Uint: %w: %d is out of range for int64", ErrOverflow, i.Uint64)
+	}
+	return int64(i.Uint64), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports the same input as Int and returns an error if the value is negative.
+func (i *Uint) UnmarshalJSON(data []byte) error {
+	return reportError(i.unmarshalJSON(data))
+}
+
+func (i *Uint) unmarshalJSON(data []byte) error {
+	var n Int
+	err := n.unmarshalJSON(data)
+	return i.set(n, err)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports the same input as Int and returns an error if the value is negative.
+func (i *Uint) UnmarshalText(text []byte) error {
+	return reportError(i.unmarshalText(text))
+}
+
+func (i *Uint) unmarshalText(text []byte) error {
+	var n Int
+	err := n.unmarshalText(text)
+	return i.set(n, err)
+}
+
+// set stores n if err is nil and n isn't negative, otherwise it makes i null and returns the error.
+func (i *Uint) set(n Int, err error) error {
+	if err == nil {
+		err = checkIntRange(n, 0, math.MaxInt64, "uint")
+	}
+	if err != nil {
+		i.Valid = false
+		return retypeError("Uint", err)
+	}
+	i.Uint64, i.Valid = uint64(n.Int64), n.Valid
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint is null.
+func (i Uint) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(i.Uint64, 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Uint is null.
+func (i Uint) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatUint(i.Uint64, 10)), nil
+}
+
+// SetValid changes this Uint's value and also sets it to be non-null.
+func (i *Uint) SetValid(n uint64) {
+	i.Uint64 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
+func (i Uint) Ptr() *uint64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint64
+}
+
+// Reset makes this Uint null and clears its value, so a reused Uint doesn't keep previous data.
+func (i *Uint) Reset() {
+	*i = Uint{}
+}
+
+// IsZero returns true for invalid Uints.
+// A non-null Uint with a 0 value will not be considered zero.
+func (i Uint) IsZero() bool {
+	return !i.Valid
+}
+
+// AsAny returns nil if this Uint is null, otherwise its value as uint64.
+// It is meant for building dynamic structures such as map[string]interface{}.
+func (i Uint) AsAny() interface{} {
+	if !i.Valid {
+		return nil
+	}
+	return i.Uint64
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Uint) Equal(other Uint) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Uint64 == other.Uint64)
+}
+
+// JSONSchema returns the JSON Schema of this Uint's JSON encoding: a non-negative integer or null.
+func (i Uint) JSONSchema() map[string]interface{} {
+	schema := nullableSchema("integer")
+	schema["minimum"] = 0
+	return schema
+}
+
+// GoString implements fmt.GoStringer, so %#v prints this Uint as the Go code that creates it.
+func (i Uint) GoString() string {
+	if !i.Valid {
+		return `null.NewUint(0, false)`
+	}
+	return "null.UintFrom(" + strconv.FormatUint(i.Uint64, 10) + ")"
+}
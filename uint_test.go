@@ -0,0 +1,44 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestUintBounds(t *testing.T) {
+	var u Uint
+	err := json.Unmarshal(intJSON, &u)
+	maybePanic(err)
+	if !u.Equal(UintFrom(12345)) {
+		t.Errorf("bad unmarshal: %#v", u)
+	}
+
+	err = u.Scan(int64(-1))
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, not %v", err)
+	}
+	if u.Valid {
+		t.Error("negative uint should be invalid")
+	}
+
+	if _, err := UintFrom(math.MaxInt64 + 1).Value(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow for value above max int64, not %v", err)
+	}
+	v, err := UintFrom(math.MaxInt64).Value()
+	maybePanic(err)
+	if v != int64(math.MaxInt64) {
+		t.Errorf("bad value: %#v", v)
+	}
+}
+
+func TestUintMarshal(t *testing.T) {
+	data, err := json.Marshal(UintFrom(12345))
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "uint json marshal")
+
+	data, err = json.Marshal(NewUint(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null uint json marshal")
+}
@@ -0,0 +1,205 @@
+package null
+
+import (
+	"context"
+	"fmt"
+)
+
+// ValidatedString is a String that checks every value it receives with a validation function,
+// whether it comes from JSON, text, SQL, or SetValid. Null values are not validated.
+// A value that fails validation leaves the ValidatedString null, and the error is returned.
+type ValidatedString struct {
+	String
+
+	validate func(string) error
+}
+
+// NewValidatedString creates a new null ValidatedString that checks values with validate.
+func NewValidatedString(validate func(string) error) ValidatedString {
+	return ValidatedString{validate: validate}
+}
+
+// SetValid validates v, and if it passes changes this ValidatedString's value and sets it to be non-null.
+func (s *ValidatedString) SetValid(v string) error {
+	s.String.SetValid(v)
+	if err := s.check(); err != nil {
+		return fmt.Errorf("null: ValidatedString: %w", err)
+	}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *ValidatedString) Scan(value interface{}) error {
+	return reportError(s.scan(value))
+}
+
+func (s *ValidatedString) scan(value interface{}) error {
+	if err := s.String.scan(value); err != nil {
+		return retypeError("ValidatedString", err)
+	}
+	if err := s.check(); err != nil {
+		return newScanError("ValidatedString", value, err)
+	}
+	return nil
+}
+
+// ScanContext is like Scan, but returns ctx.Err() without scanning if ctx is already done.
+// It overrides String.ScanContext, which would skip validation.
+func (s *ValidatedString) ScanContext(ctx context.Context, value interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.Scan(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports the same input as String.
+func (s *ValidatedString) UnmarshalJSON(data []byte) error {
+	return reportError(s.unmarshalJSON(data))
+}
+
+func (s *ValidatedString) unmarshalJSON(data []byte) error {
+	if err := s.String.unmarshalJSON(data); err != nil {
+		return retypeError("ValidatedString", err)
+	}
+	if err := s.check(); err != nil {
+		return newUnmarshalError("ValidatedString", err)
+	}
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null ValidatedString if the input is a blank string.
+func (s *ValidatedString) UnmarshalText(text []byte) error {
+	return reportError(s.unmarshalText(text))
+}
+
+func (s *ValidatedString) unmarshalText(text []byte) error {
+	if err := s.String.UnmarshalText(text); err != nil {
+		return retypeError("ValidatedString", err)
+	}
+	if err := s.check(); err != nil {
+		return newUnmarshalError("ValidatedString", err)
+	}
+	return nil
+}
+
+// Equal returns true if both ValidatedStrings have the same value or are both null.
+func (s ValidatedString) Equal(other ValidatedString) bool {
+	return s.String.Equal(other.String)
+}
+
+// GoString implements fmt.GoStringer. The validation function can't be printed, so only the value is shown.
+func (s ValidatedString) GoString() string {
+	if !s.Valid {
+		return "null.ValidatedString{}"
+	}
+	return "null.ValidatedString{String: " + s.String.GoString() + "}"
+}
+
+// check validates a valid value, making it null if it fails.
+func (s *ValidatedString) check() error {
+	if !s.Valid || s.validate == nil {
+		return nil
+	}
+	if err := s.validate(s.String.String); err != nil {
+		s.Valid = false
+		return err
+	}
+	return nil
+}
+
+// ValidatedInt is an Int that checks every value it receives with a validation function,
+// whether it comes from JSON, text, SQL, or SetValid. Null values are not validated.
+// A value that fails validation leaves the ValidatedInt null, and the error is returned.
+type ValidatedInt struct {
+	Int
+
+	validate func(int64) error
+}
+
+// NewValidatedInt creates a new null ValidatedInt that checks values with validate.
+func NewValidatedInt(validate func(int64) error) ValidatedInt {
+	return ValidatedInt{validate: validate}
+}
+
+// SetValid validates n, and if it passes changes this ValidatedInt's value and sets it to be non-null.
+func (i *ValidatedInt) SetValid(n int64) error {
+	i.Int.SetValid(n)
+	if err := i.check(); err != nil {
+		return fmt.Errorf("null: ValidatedInt: %w", err)
+	}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *ValidatedInt) Scan(value interface{}) error {
+	return reportError(i.scan(value))
+}
+
+func (i *ValidatedInt) scan(value interface{}) error {
+	if err := i.Int.scan(value); err != nil {
+		return retypeError("ValidatedInt", err)
+	}
+	if err := i.check(); err != nil {
+		return newScanError("ValidatedInt", value, err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports the same input as Int.
+func (i *ValidatedInt) UnmarshalJSON(data []byte) error {
+	return reportError(i.unmarshalJSON(data))
+}
+
+func (i *ValidatedInt) unmarshalJSON(data []byte) error {
+	if err := i.Int.unmarshalJSON(data); err != nil {
+		return retypeError("ValidatedInt", err)
+	}
+	if err := i.check(); err != nil {
+		return newUnmarshalError("ValidatedInt", err)
+	}
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports the same input as Int.
+func (i *ValidatedInt) UnmarshalText(text []byte) error {
+	return reportError(i.unmarshalText(text))
+}
+
+func (i *ValidatedInt) unmarshalText(text []byte) error {
+	if err := i.Int.unmarshalText(text); err != nil {
+		return retypeError("ValidatedInt", err)
+	}
+	if err := i.check(); err != nil {
+		return newUnmarshalError("ValidatedInt", err)
+	}
+	return nil
+}
+
+// Equal returns true if both ValidatedInts have the same value or are both null.
+func (i ValidatedInt) Equal(other ValidatedInt) bool {
+	return i.Int.Equal(other.Int)
+}
+
+// GoString implements fmt.GoStringer. The validation function can't be printed, so only the value is shown.
+func (i ValidatedInt) GoString() string {
+	if !i.Valid {
+		return "null.ValidatedInt{}"
+	}
+	return "null.ValidatedInt{Int: " + i.Int.GoString() + "}"
+}
+
+// check validates a valid value, making it null if it fails.
+func (i *ValidatedInt) check() error {
+	if !i.Valid || i.validate == nil {
+		return nil
+	}
+	if err := i.validate(i.Int64); err != nil {
+		i.Valid = false
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+var errNotLower = errors.New("not lower case")
+
+func lowerOnly(s string) error {
+	if s != strings.ToLower(s) {
+		return errNotLower
+	}
+	return nil
+}
+
+var errNegative = errors.New("negative")
+
+func nonNegative(n int64) error {
+	if n < 0 {
+		return errNegative
+	}
+	return nil
+}
+
+func TestValidatedString(t *testing.T) {
+	s := NewValidatedString(lowerOnly)
+	if err := s.SetValid("test"); err != nil {
+		t.Error("unexpected error:", err)
+	}
+	assertStr(t, s.String, "SetValid() valid")
+	if err := s.SetValid("Test"); !errors.Is(err, errNotLower) {
+		t.Errorf("SetValid(): expected errNotLower, not %v", err)
+	}
+	assertNullStr(t, s.String, "SetValid() invalid")
+
+	s = NewValidatedString(lowerOnly)
+	if err := json.Unmarshal([]byte(`"Test"`), &s); !errors.Is(err, errNotLower) {
+		t.Errorf("UnmarshalJSON(): expected errNotLower, not %v", err)
+	}
+	assertNullStr(t, s.String, "UnmarshalJSON() invalid")
+	err := json.Unmarshal(stringJSON, &s)
+	maybePanic(err)
+	assertStr(t, s.String, "UnmarshalJSON() valid")
+
+	if err := s.Scan("Test"); !errors.Is(err, errNotLower) {
+		t.Errorf("Scan(): expected errNotLower, not %v", err)
+	}
+	assertNullStr(t, s.String, "Scan() invalid")
+	var unmarshalErr *UnmarshalError
+	if err := s.UnmarshalText([]byte("Test")); !errors.As(err, &unmarshalErr) || unmarshalErr.Type != "ValidatedString" {
+		t.Errorf("UnmarshalText(): expected ValidatedString UnmarshalError, not %v", err)
+	}
+
+	// null values are not validated
+	err = s.Scan(nil)
+	maybePanic(err)
+	err = json.Unmarshal(nullJSON, &s)
+	maybePanic(err)
+	assertNullStr(t, s.String, "null")
+}
+
+func TestValidatedInt(t *testing.T) {
+	i := NewValidatedInt(nonNegative)
+	if err := i.SetValid(12345); err != nil {
+		t.Error("unexpected error:", err)
+	}
+	assertInt(t, i.Int, "SetValid() valid")
+	if err := i.SetValid(-1); !errors.Is(err, errNegative) {
+		t.Errorf("SetValid(): expected errNegative, not %v", err)
+	}
+	assertNullInt(t, i.Int, "SetValid() invalid")
+
+	if err := json.Unmarshal([]byte(`-5`), &i); !errors.Is(err, errNegative) {
+		t.Errorf("UnmarshalJSON(): expected errNegative, not %v", err)
+	}
+	assertNullInt(t, i.Int, "UnmarshalJSON() invalid")
+
+	if err := i.Scan(int64(-5)); !errors.Is(err, errNegative) {
+		t.Errorf("Scan(): expected errNegative, not %v", err)
+	}
+	assertNullInt(t, i.Int, "Scan() invalid")
+	err := i.Scan(int64(12345))
+	maybePanic(err)
+	assertInt(t, i.Int, "Scan() valid")
+
+	if err := i.UnmarshalText([]byte("-5")); !errors.Is(err, errNegative) {
+		t.Errorf("UnmarshalText(): expected errNegative, not %v", err)
+	}
+}
@@ -46,6 +46,7 @@ func (b Bool) ValueOrZero() bool {
 // UnmarshalJSON implements json.Unmarshaler.
 // "false" will be considered a null Bool.
 func (b *Bool) UnmarshalJSON(data []byte) error {
+	data = trimJSON(data)
 	if bytes.Equal(data, nullBytes) {
 		b.Valid = false
 		return nil
@@ -53,6 +53,7 @@ func (f Float) ValueOrZero() float64 {
 // It supports number and null input.
 // 0 will be considered a null Float.
 func (f *Float) UnmarshalJSON(data []byte) error {
+	data = trimJSON(data)
 	if bytes.Equal(data, nullBytes) {
 		f.Valid = false
 		return nil
@@ -52,6 +52,7 @@ func (i Int) ValueOrZero() int64 {
 // It supports number and null input.
 // 0 will be considered a null Int.
 func (i *Int) UnmarshalJSON(data []byte) error {
+	data = trimJSON(data)
 	if bytes.Equal(data, nullBytes) {
 		i.Valid = false
 		return nil
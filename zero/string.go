@@ -14,6 +14,15 @@ import (
 // nullBytes is a JSON null literal
 var nullBytes = []byte("null")
 
+// utf8BOM is the UTF-8 encoded byte order mark.
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// trimJSON removes a leading byte order mark and surrounding white space from a JSON value,
+// like the null package does, so UnmarshalJSON called directly on padded input still detects null.
+func trimJSON(data []byte) []byte {
+	return bytes.TrimSpace(bytes.TrimPrefix(bytes.TrimSpace(data), utf8BOM))
+}
+
 // String is a nullable string.
 // JSON marshals to a blank string if null.
 // Considered null to SQL if zero.
@@ -56,6 +65,7 @@ func (s String) ValueOrZero() string {
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports string and null input. Blank string input produces a null String.
 func (s *String) UnmarshalJSON(data []byte) error {
+	data = trimJSON(data)
 	if bytes.Equal(data, nullBytes) {
 		s.Valid = false
 		return nil
@@ -194,6 +194,48 @@ func TestStringEqual(t *testing.T) {
 	assertStringEqualIsFalse(t, str1, str2)
 }
 
+func TestUnmarshalJSONTrimsBOMAndSpace(t *testing.T) {
+	bom := "\xef\xbb\xbf"
+	for _, null := range []string{bom + "null", " null\n", bom + "\tnull "} {
+		s, i, f, b, tm := StringFrom("x"), IntFrom(1), FloatFrom(1), BoolFrom(true), TimeFrom(timeValue1)
+		for _, u := range []json.Unmarshaler{&s, &i, &f, &b, &tm} {
+			if err := u.UnmarshalJSON([]byte(null)); err != nil {
+				t.Errorf("%T: unexpected error for %q: %v", u, null, err)
+			}
+		}
+		assertNullStr(t, s, "padded null")
+		assertNullInt(t, i, "padded null")
+		assertNullFloat(t, f, "padded null")
+		assertNullBool(t, b, "padded null")
+		assertNullTime(t, tm, "padded null")
+	}
+
+	var s String
+	err := s.UnmarshalJSON([]byte(bom + ` "test" `))
+	maybePanic(err)
+	assertStr(t, s, "padded string")
+
+	var i Int
+	err = i.UnmarshalJSON([]byte(bom + " 12345\r\n"))
+	maybePanic(err)
+	assertInt(t, i, "padded int")
+
+	var f Float
+	err = f.UnmarshalJSON([]byte(" 1.2345 "))
+	maybePanic(err)
+	assertFloat(t, f, "padded float")
+
+	var b Bool
+	err = b.UnmarshalJSON([]byte(bom + "true\n"))
+	maybePanic(err)
+	assertBool(t, b, "padded bool")
+
+	var tm Time
+	err = tm.UnmarshalJSON(append([]byte(bom+" "), timeJSON...))
+	maybePanic(err)
+	assertTime(t, tm, "padded time")
+}
+
 func maybePanic(err error) {
 	if err != nil {
 		panic(err)
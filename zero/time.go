@@ -69,6 +69,7 @@ func (t Time) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports string and null input.
 func (t *Time) UnmarshalJSON(data []byte) error {
+	data = trimJSON(data)
 	switch string(data) {
 	case "null", `""`:
 		t.Valid = false